@@ -1,3 +1,7 @@
 data "duo_user" "user" {
   user_id = "XXXXXXXXXXXXXXXXXXXX"
-}
\ No newline at end of file
+}
+
+data "duo_user" "by_username" {
+  username = "testos.terone"
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// AdministrativeUnit models an administrative unit.
+type AdministrativeUnit struct {
+	AdminUnitID            string   `json:"admin_unit_id"`
+	Name                   string   `json:"name"`
+	Description            string   `json:"description"`
+	RestrictByGroups       bool     `json:"restrict_by_groups"`
+	RestrictByIntegrations bool     `json:"restrict_by_integrations"`
+	Admins                 []string `json:"admins"`
+	Groups                 []string `json:"groups"`
+	Integrations           []string `json:"integrations"`
+}
+
+// CreateAdministrativeUnit calls POST /admin/v1/administrative_units.
+func (c *Client) CreateAdministrativeUnit(ctx context.Context, params url.Values) (*AdministrativeUnit, error) {
+	unit := &AdministrativeUnit{}
+	if _, err := c.call(ctx, http.MethodPost, "/admin/v1/administrative_units", params, unit); err != nil {
+		return nil, err
+	}
+	return unit, nil
+}
+
+// GetAdministrativeUnit calls GET /admin/v1/administrative_units/:admin_unit_id.
+func (c *Client) GetAdministrativeUnit(ctx context.Context, adminUnitID string) (*AdministrativeUnit, error) {
+	unit := &AdministrativeUnit{}
+	if _, err := c.call(ctx, http.MethodGet, fmt.Sprintf("/admin/v1/administrative_units/%s", adminUnitID), nil, unit); err != nil {
+		return nil, err
+	}
+	return unit, nil
+}
+
+// UpdateAdministrativeUnit calls POST /admin/v1/administrative_units/:admin_unit_id.
+func (c *Client) UpdateAdministrativeUnit(ctx context.Context, adminUnitID string, params url.Values) (*AdministrativeUnit, error) {
+	unit := &AdministrativeUnit{}
+	if _, err := c.callRepeatable(ctx, http.MethodPost, fmt.Sprintf("/admin/v1/administrative_units/%s", adminUnitID), params, unit); err != nil {
+		return nil, err
+	}
+	return unit, nil
+}
+
+// DeleteAdministrativeUnit calls DELETE /admin/v1/administrative_units/:admin_unit_id.
+func (c *Client) DeleteAdministrativeUnit(ctx context.Context, adminUnitID string) error {
+	_, err := c.call(ctx, http.MethodDelete, fmt.Sprintf("/admin/v1/administrative_units/%s", adminUnitID), nil, nil)
+	return err
+}
+
+// AddAdministrativeUnitMember calls
+// POST /admin/v1/administrative_units/:admin_unit_id/:kind/:member_id, where
+// kind is one of `admin`, `group` or `integration`.
+func (c *Client) AddAdministrativeUnitMember(ctx context.Context, adminUnitID, kind, memberID string) error {
+	_, err := c.callRepeatable(ctx, http.MethodPost, fmt.Sprintf("/admin/v1/administrative_units/%s/%s/%s", adminUnitID, kind, memberID), nil, nil)
+	return err
+}
+
+// RemoveAdministrativeUnitMember calls
+// DELETE /admin/v1/administrative_units/:admin_unit_id/:kind/:member_id, where
+// kind is one of `admin`, `group` or `integration`.
+func (c *Client) RemoveAdministrativeUnitMember(ctx context.Context, adminUnitID, kind, memberID string) error {
+	_, err := c.call(ctx, http.MethodDelete, fmt.Sprintf("/admin/v1/administrative_units/%s/%s/%s", adminUnitID, kind, memberID), nil, nil)
+	return err
+}
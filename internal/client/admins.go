@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Admin models an Admin Panel administrator.
+type Admin struct {
+	AdminID                string `json:"admin_id"`
+	Email                  string `json:"email"`
+	Name                   string `json:"name"`
+	Phone                  string `json:"phone"`
+	Role                   string `json:"role"`
+	RestrictedByAdminUnits bool   `json:"restricted_by_admin_units"`
+	Status                 string `json:"status"`
+}
+
+// CreateAdmin calls POST /admin/v1/admins.
+func (c *Client) CreateAdmin(ctx context.Context, params url.Values) (*Admin, error) {
+	admin := &Admin{}
+	if _, err := c.call(ctx, http.MethodPost, "/admin/v1/admins", params, admin); err != nil {
+		return nil, err
+	}
+	return admin, nil
+}
+
+// GetAdmin calls GET /admin/v1/admins/:admin_id.
+func (c *Client) GetAdmin(ctx context.Context, adminID string) (*Admin, error) {
+	admin := &Admin{}
+	if _, err := c.call(ctx, http.MethodGet, fmt.Sprintf("/admin/v1/admins/%s", adminID), nil, admin); err != nil {
+		return nil, err
+	}
+	return admin, nil
+}
+
+// UpdateAdmin calls POST /admin/v1/admins/:admin_id.
+func (c *Client) UpdateAdmin(ctx context.Context, adminID string, params url.Values) (*Admin, error) {
+	admin := &Admin{}
+	if _, err := c.callRepeatable(ctx, http.MethodPost, fmt.Sprintf("/admin/v1/admins/%s", adminID), params, admin); err != nil {
+		return nil, err
+	}
+	return admin, nil
+}
+
+// DeleteAdmin calls DELETE /admin/v1/admins/:admin_id.
+func (c *Client) DeleteAdmin(ctx context.Context, adminID string) error {
+	_, err := c.call(ctx, http.MethodDelete, fmt.Sprintf("/admin/v1/admins/%s", adminID), nil, nil)
+	return err
+}
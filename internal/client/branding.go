@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Branding models the live or draft custom branding.
+type Branding struct {
+	BackgroundImg          string `json:"background_img"`
+	CardAccentColor        string `json:"card_accent_color"`
+	Logo                   string `json:"logo"`
+	PageBackgroundColor    string `json:"page_background_color"`
+	PoweredByDuo           bool   `json:"powered_by_duo"`
+	SSOCustomUsernameLabel string `json:"sso_custom_username_label"`
+}
+
+// GetBranding calls GET /admin/v1/branding.
+func (c *Client) GetBranding(ctx context.Context) (*Branding, error) {
+	branding := &Branding{}
+	if _, err := c.call(ctx, http.MethodGet, "/admin/v1/branding", nil, branding); err != nil {
+		return nil, err
+	}
+	return branding, nil
+}
+
+// GetDraftBranding calls GET /admin/v1/branding/draft.
+func (c *Client) GetDraftBranding(ctx context.Context) (*Branding, error) {
+	branding := &Branding{}
+	if _, err := c.call(ctx, http.MethodGet, "/admin/v1/branding/draft", nil, branding); err != nil {
+		return nil, err
+	}
+	return branding, nil
+}
+
+// UpdateDraftBranding calls POST /admin/v1/branding/draft.
+func (c *Client) UpdateDraftBranding(ctx context.Context, params url.Values) (*Branding, error) {
+	branding := &Branding{}
+	if _, err := c.callRepeatable(ctx, http.MethodPost, "/admin/v1/branding/draft", params, branding); err != nil {
+		return nil, err
+	}
+	return branding, nil
+}
+
+// PublishDraftBranding calls POST /admin/v1/branding/draft/publish.
+func (c *Client) PublishDraftBranding(ctx context.Context) error {
+	_, err := c.callRepeatable(ctx, http.MethodPost, "/admin/v1/branding/draft/publish", nil, nil)
+	return err
+}
@@ -0,0 +1,27 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// BypassCode models the metadata Duo keeps about a bypass code. The code
+// itself is only ever returned when it is created.
+type BypassCode struct {
+	BypassCodeID string `json:"bypass_code_id"`
+	Created      int64  `json:"created"`
+	Expiration   *int64 `json:"expiration"`
+	ReuseCount   int    `json:"reuse_count"`
+}
+
+// GetBypassCodes calls GET /admin/v1/bypass_codes and returns every page.
+func (c *Client) GetBypassCodes(ctx context.Context) ([]BypassCode, error) {
+	return list[BypassCode](ctx, c, "/admin/v1/bypass_codes", nil, 500)
+}
+
+// DeleteBypassCode calls DELETE /admin/v1/bypass_codes/:bypass_code_id.
+func (c *Client) DeleteBypassCode(ctx context.Context, bypassCodeID string) error {
+	_, err := c.call(ctx, http.MethodDelete, fmt.Sprintf("/admin/v1/bypass_codes/%s", bypassCodeID), nil, nil)
+	return err
+}
@@ -0,0 +1,385 @@
+// Package client implements the parts of the Duo Admin API used by the
+// provider. Every call goes through the same signing, decoding, error and
+// retry handling, so resources only deal with typed values and *Error.
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	duoapi "github.com/duosecurity/duo_api_golang"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+// Config configures a Client.
+type Config struct {
+	IntegrationKey string
+	SecretKey      string
+	APIHostname    string
+	UserAgent      string
+
+	// BaseURL overrides the scheme and host derived from APIHostname, e.g.
+	// to reach a local stand-in server over plain HTTP.
+	BaseURL string
+	// HTTPProxy is the URL of the proxy requests are sent through. When
+	// empty, the standard HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment
+	// variables are honored.
+	HTTPProxy string
+	// CACertPEM holds certificates trusted in addition to the certificate
+	// authorities Duo pins, e.g. the CA of a TLS intercepting proxy.
+	CACertPEM []byte
+
+	// RequestTimeout bounds each attempt of a request. Zero means no
+	// timeout other than the deadline of the caller's context.
+	RequestTimeout time.Duration
+
+	// MaxRetries is the number of times a rate limited request is retried.
+	MaxRetries int
+	// RetryMaxWait caps the wait between two attempts of a request.
+	RetryMaxWait time.Duration
+
+	// RequestsPerSecond limits the rate of requests sent by the client,
+	// including retries. Zero means no limit.
+	RequestsPerSecond float64
+	// MaxConcurrentRequests limits the number of requests in flight at the
+	// same time. Zero means no limit.
+	MaxConcurrentRequests int
+}
+
+// Client is a Duo Admin API client. It is safe for concurrent use.
+type Client struct {
+	config     Config
+	baseURL    *url.URL
+	httpClient *http.Client
+
+	// limiter and slots are shared by every resource using the client, so
+	// that parallel operations of an apply are throttled together.
+	limiter *rate.Limiter
+	slots   chan struct{}
+}
+
+// New returns a client for the Admin API of the given account.
+func New(config Config) (*Client, error) {
+	baseURL := &url.URL{Scheme: "https", Host: config.APIHostname}
+	if config.BaseURL != "" {
+		u, err := url.Parse(config.BaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base URL: %s", err)
+		}
+		if (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return nil, fmt.Errorf("invalid base URL %q: expected http(s)://host[:port]", config.BaseURL)
+		}
+		baseURL = &url.URL{Scheme: u.Scheme, Host: u.Host}
+	}
+	if baseURL.Host == "" {
+		return nil, errors.New("an API hostname or base URL is required")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	rootCAs := duoRootCAs()
+	if len(config.CACertPEM) > 0 && !rootCAs.AppendCertsFromPEM(config.CACertPEM) {
+		return nil, errors.New("no certificate found in the CA bundle")
+	}
+	transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs}
+
+	if config.HTTPProxy != "" {
+		proxy, err := url.Parse(config.HTTPProxy)
+		if err != nil || proxy.Host == "" {
+			return nil, fmt.Errorf("invalid HTTP proxy URL %q", config.HTTPProxy)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	c := &Client{
+		config:     config,
+		baseURL:    baseURL,
+		httpClient: &http.Client{Transport: transport},
+		limiter:    rate.NewLimiter(rate.Inf, 0),
+	}
+	if config.RequestsPerSecond > 0 {
+		c.limiter = rate.NewLimiter(rate.Limit(config.RequestsPerSecond), 1)
+	}
+	if config.MaxConcurrentRequests > 0 {
+		c.slots = make(chan struct{}, config.MaxConcurrentRequests)
+	}
+	return c, nil
+}
+
+// duoRootCAs returns a pool of the certificate authorities Duo pins its API
+// certificates to. duo_api_golang does not export them, so they are taken
+// from the transport it builds.
+func duoRootCAs() *x509.CertPool {
+	var pool *x509.CertPool
+	duoapi.NewDuoApi("", "", "", "", duoapi.SetTransport(func(tr *http.Transport) {
+		pool = tr.TLSClientConfig.RootCAs
+	}))
+	return pool
+}
+
+// acquire waits until a request may be sent, and returns a function that
+// must be called once its response has been read.
+func (c *Client) acquire(ctx context.Context) (func(), error) {
+	if c.slots != nil {
+		select {
+		case c.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if c.slots != nil {
+			<-c.slots
+		}
+	}
+
+	if err := c.limiter.Wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// request describes a call to the Admin API.
+type request struct {
+	method string
+	path   string
+	// params are sent as form parameters, or as a JSON body when json is set.
+	params     url.Values
+	jsonParams map[string]any
+	json       bool
+	// repeatable marks requests that are safe to send again when they are
+	// rate limited. GET, PUT and DELETE requests always are.
+	repeatable bool
+}
+
+// response is the envelope of every Admin API response.
+type response struct {
+	Stat          string          `json:"stat"`
+	Code          errorCode       `json:"code"`
+	Message       string          `json:"message"`
+	MessageDetail string          `json:"message_detail"`
+	Response      json.RawMessage `json:"response"`
+	Metadata      struct {
+		NextOffset json.Number `json:"next_offset"`
+	} `json:"metadata"`
+}
+
+// errorCode decodes error codes, which Duo returns as a number but
+// occasionally as a string.
+type errorCode int
+
+func (c *errorCode) UnmarshalJSON(data []byte) error {
+	var raw any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	switch v := raw.(type) {
+	case float64:
+		*c = errorCode(v)
+	case string:
+		i, _ := strconv.Atoi(v)
+		*c = errorCode(i)
+	}
+	return nil
+}
+
+// call makes a request with form parameters, signed for Admin API v1, and
+// decodes the response into out unless it is nil.
+func (c *Client) call(ctx context.Context, method, path string, params url.Values, out any) (*response, error) {
+	return c.send(ctx, &request{method: method, path: path, params: params}, out)
+}
+
+// callRepeatable is call for POST requests that are safe to send again, such
+// as updates of an existing object.
+func (c *Client) callRepeatable(ctx context.Context, method, path string, params url.Values, out any) (*response, error) {
+	return c.send(ctx, &request{method: method, path: path, params: params, repeatable: true}, out)
+}
+
+// jsonCall makes a request with a JSON body, signed for Admin API v2, and
+// decodes the response into out unless it is nil.
+func (c *Client) jsonCall(ctx context.Context, method, path string, params map[string]any, out any) (*response, error) {
+	return c.send(ctx, &request{method: method, path: path, jsonParams: params, json: true}, out)
+}
+
+// send makes a request, retrying it while it is rate limited.
+func (c *Client) send(ctx context.Context, req *request, out any) (*response, error) {
+	repeatable := req.repeatable || req.method == http.MethodGet || req.method == http.MethodPut || req.method == http.MethodDelete
+
+	for attempt := 0; ; attempt++ {
+		status, header, body, err := c.roundTrip(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		r, err := decode(status, body, out)
+		if err == nil || !isRateLimited(err) || !repeatable || attempt >= c.config.MaxRetries {
+			return r, err
+		}
+
+		wait := retryWait(attempt, header.Get("Retry-After"), c.config.RetryMaxWait)
+		tflog.Warn(ctx, "Duo API rate limit reached, retrying", map[string]any{
+			"method":  req.method,
+			"path":    req.path,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		})
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// roundTrip signs and sends a single attempt of a request.
+func (c *Client) roundTrip(ctx context.Context, req *request) (int, http.Header, []byte, error) {
+	// Wait for a slot before signing, so the signed date stays current.
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	defer release()
+
+	if c.config.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.config.RequestTimeout)
+		defer cancel()
+	}
+
+	date := time.Now().UTC().Format(time.RFC1123Z)
+	hasBody := req.method == http.MethodPost || req.method == http.MethodPut || req.method == http.MethodPatch
+
+	u := url.URL{
+		Scheme: c.baseURL.Scheme,
+		Host:   c.baseURL.Host,
+		Path:   req.path,
+	}
+
+	var body io.Reader
+	var contentType, authorization string
+
+	if req.json {
+		query := url.Values{}
+		payload := ""
+		if hasBody {
+			b, err := json.Marshal(req.jsonParams)
+			if err != nil {
+				return 0, nil, nil, err
+			}
+			payload = string(b)
+			body = strings.NewReader(payload)
+			contentType = "application/json"
+		} else {
+			for k, v := range req.jsonParams {
+				query.Set(k, fmt.Sprintf("%v", v))
+			}
+			u.RawQuery = query.Encode()
+		}
+		authorization = signJSON(c.config.IntegrationKey, c.config.SecretKey, date, req.method, u.Host, req.path, query, payload)
+	} else {
+		params := req.params
+		if params == nil {
+			params = url.Values{}
+		}
+		authorization = sign(c.config.IntegrationKey, c.config.SecretKey, date, req.method, u.Host, req.path, params)
+		if hasBody {
+			body = strings.NewReader(params.Encode())
+			contentType = "application/x-www-form-urlencoded"
+		} else {
+			u.RawQuery = params.Encode()
+		}
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, req.method, u.String(), body)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	httpReq.Header.Set("Authorization", authorization)
+	httpReq.Header.Set("Date", date)
+	httpReq.Header.Set("User-Agent", c.config.UserAgent)
+	if contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	return resp.StatusCode, resp.Header, data, nil
+}
+
+func decode(status int, body []byte, out any) (*response, error) {
+	r := &response{}
+	if err := json.Unmarshal(body, r); err != nil {
+		if status >= http.StatusBadRequest {
+			return nil, &Error{StatusCode: status, Message: http.StatusText(status)}
+		}
+		return nil, fmt.Errorf("unable to decode response: %s", err)
+	}
+
+	if r.Stat != "OK" {
+		e := &Error{
+			StatusCode:    status,
+			Code:          int(r.Code),
+			Message:       r.Message,
+			MessageDetail: r.MessageDetail,
+		}
+		if e.Message == "" {
+			e.Message = http.StatusText(status)
+		}
+		return nil, e
+	}
+
+	if out != nil && len(r.Response) > 0 {
+		if err := json.Unmarshal(r.Response, out); err != nil {
+			return nil, fmt.Errorf("unable to decode response: %s", err)
+		}
+	}
+	return r, nil
+}
+
+// list retrieves every page of a paginated endpoint, limit items at a time.
+func list[T any](ctx context.Context, c *Client, path string, params url.Values, limit int) ([]T, error) {
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("limit", strconv.Itoa(limit))
+
+	items := []T{}
+
+	offset := "0"
+	for {
+		params.Set("offset", offset)
+
+		page := []T{}
+		r, err := c.call(ctx, http.MethodGet, path, params, &page)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+
+		if r.Metadata.NextOffset == "" {
+			break
+		}
+		offset = r.Metadata.NextOffset.String()
+	}
+
+	return items, nil
+}
@@ -0,0 +1,406 @@
+package client
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// newTestClient returns a client talking to a server answering with handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewTLSServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := New(Config{
+		IntegrationKey: "DIXXXXXXXXXXXXXXXXXX",
+		SecretKey:      "secret",
+		APIHostname:    strings.TrimPrefix(server.URL, "https://"),
+		UserAgent:      "test",
+		MaxRetries:     2,
+		RetryMaxWait:   time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	c.httpClient = server.Client()
+	return c
+}
+
+func TestGetUser(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/admin/v1/users/DUXXXXXXXXXXXXXXXXXX" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") == "" {
+			t.Error("request is not signed")
+		}
+		fmt.Fprint(w, `{"stat": "OK", "response": {"user_id": "DUXXXXXXXXXXXXXXXXXX", "username": "test"}}`)
+	})
+
+	user, err := c.GetUser(context.Background(), "DUXXXXXXXXXXXXXXXXXX")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if user.Username != "test" {
+		t.Errorf("expected username %q, got %q", "test", user.Username)
+	}
+}
+
+func TestError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"stat": "FAIL", "code": 40401, "message": "Resource not found", "message_detail": "user_id"}`)
+	})
+
+	_, err := c.GetUser(context.Background(), "DUXXXXXXXXXXXXXXXXXX")
+
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("expected *Error, got %T: %v", err, err)
+	}
+	if e.StatusCode != http.StatusNotFound || e.Code != 40401 || e.Message != "Resource not found" || e.MessageDetail != "user_id" {
+		t.Errorf("unexpected error: %#v", e)
+	}
+	if !IsNotFound(err) {
+		t.Error("expected IsNotFound to be true")
+	}
+	if expected := "Resource not found: user_id (HTTP 404, code 40401)"; err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+}
+
+func TestErrorWithoutMessage(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"stat": "FAIL", "code": "40002"}`)
+	})
+
+	err := c.DeleteUser(context.Background(), "DUXXXXXXXXXXXXXXXXXX")
+
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("expected *Error, got %T: %v", err, err)
+	}
+	if e.Code != 40002 || e.Message != "Bad Request" {
+		t.Errorf("unexpected error: %#v", e)
+	}
+	if IsNotFound(err) {
+		t.Error("expected IsNotFound to be false")
+	}
+}
+
+func TestList(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			fmt.Fprint(w, `{"stat": "OK", "response": [{"group_id": "DG1"}], "metadata": {"next_offset": 1}}`)
+		case "1":
+			fmt.Fprint(w, `{"stat": "OK", "response": [{"group_id": "DG2"}], "metadata": {}}`)
+		default:
+			t.Errorf("unexpected offset: %s", r.URL.Query().Get("offset"))
+		}
+	})
+
+	groups, err := c.GetGroups(context.Background())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(groups) != 2 || groups[0].GroupID != "DG1" || groups[1].GroupID != "DG2" {
+		t.Errorf("unexpected groups: %v", groups)
+	}
+}
+
+func TestRetryRateLimited(t *testing.T) {
+	attempts := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"stat": "FAIL", "code": 42901, "message": "Too Many Requests"}`)
+			return
+		}
+		fmt.Fprint(w, `{"stat": "OK", "response": {"user_id": "DUXXXXXXXXXXXXXXXXXX"}}`)
+	})
+
+	if _, err := c.GetUser(context.Background(), "DUXXXXXXXXXXXXXXXXXX"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	attempts := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"stat": "FAIL", "code": 42901, "message": "Too Many Requests"}`)
+	})
+
+	_, err := c.GetUser(context.Background(), "DUXXXXXXXXXXXXXXXXXX")
+	if !isRateLimited(err) {
+		t.Fatalf("expected a rate limit error, got %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestNoRetryOfCreate(t *testing.T) {
+	attempts := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"stat": "FAIL", "code": 42901, "message": "Too Many Requests"}`)
+	})
+
+	if _, err := c.CreateUser(context.Background(), nil); !isRateLimited(err) {
+		t.Fatalf("expected a rate limit error, got %v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	if wait := retryWait(0, "2", time.Minute); wait != 2*time.Second {
+		t.Errorf("expected Retry-After to be honored, got %s", wait)
+	}
+	if wait := retryWait(0, "120", time.Minute); wait != time.Minute {
+		t.Errorf("expected Retry-After to be capped, got %s", wait)
+	}
+	for attempt, max := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+		if wait := retryWait(attempt, "", time.Minute); wait < max/2 || wait > max {
+			t.Errorf("attempt %d: expected a wait between %s and %s, got %s", attempt, max/2, max, wait)
+		}
+	}
+	if wait := retryWait(10, "", time.Minute); wait < 30*time.Second || wait > time.Minute {
+		t.Errorf("expected the backoff to be capped, got %s", wait)
+	}
+}
+
+func TestCanonParams(t *testing.T) {
+	params := url.Values{}
+	params.Set("username", "root")
+	params.Set("realname", "First Last")
+
+	if canon, expected := canonParams(params), "realname=First%20Last&username=root"; canon != expected {
+		t.Errorf("expected %q, got %q", expected, canon)
+	}
+}
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		fmt.Fprint(w, `{"stat": "OK", "response": {"user_id": "DUXXXXXXXXXXXXXXXXXX"}}`)
+	})
+	c.slots = make(chan struct{}, 2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetUser(context.Background(), "DUXXXXXXXXXXXXXXXXXX"); err != nil {
+				t.Errorf("err: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", maxInFlight)
+	}
+}
+
+func TestRequestsPerSecond(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"stat": "OK", "response": {"user_id": "DUXXXXXXXXXXXXXXXXXX"}}`)
+	})
+	c.limiter = rate.NewLimiter(20, 1)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if _, err := c.GetUser(context.Background(), "DUXXXXXXXXXXXXXXXXXX"); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("expected 5 requests to take at least 150ms, took %s", elapsed)
+	}
+}
+
+func TestCancel(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := c.GetUser(ctx, "DUXXXXXXXXXXXXXXXXXX"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the request to be cancelled, got %v", err)
+	}
+}
+
+func TestCancelRetryWait(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"stat": "FAIL", "code": 42901, "message": "Too Many Requests"}`)
+	})
+	c.config.RetryMaxWait = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := c.GetUser(ctx, "DUXXXXXXXXXXXXXXXXXX"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the retry to be cancelled, got %v", err)
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+	c.config.RequestTimeout = 50 * time.Millisecond
+
+	if _, err := c.GetUser(context.Background(), "DUXXXXXXXXXXXXXXXXXX"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the request to time out, got %v", err)
+	}
+}
+
+func TestBaseURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"stat": "OK", "response": {"user_id": "DUXXXXXXXXXXXXXXXXXX"}}`)
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := New(Config{
+		IntegrationKey: "DIXXXXXXXXXXXXXXXXXX",
+		SecretKey:      "secret",
+		BaseURL:        server.URL,
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, err := c.GetUser(context.Background(), "DUXXXXXXXXXXXXXXXXXX"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestInvalidBaseURL(t *testing.T) {
+	for _, baseURL := range []string{"ftp://localhost", "localhost:8080", "http://"} {
+		if _, err := New(Config{BaseURL: baseURL}); err == nil {
+			t.Errorf("expected an error for %q", baseURL)
+		}
+	}
+}
+
+func TestHTTPProxy(t *testing.T) {
+	proxied := false
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.Host == "duo.example.com"
+		fmt.Fprint(w, `{"stat": "OK", "response": {"user_id": "DUXXXXXXXXXXXXXXXXXX"}}`)
+	}))
+	t.Cleanup(proxy.Close)
+
+	c, err := New(Config{
+		IntegrationKey: "DIXXXXXXXXXXXXXXXXXX",
+		SecretKey:      "secret",
+		BaseURL:        "http://duo.example.com",
+		HTTPProxy:      proxy.URL,
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, err := c.GetUser(context.Background(), "DUXXXXXXXXXXXXXXXXXX"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !proxied {
+		t.Error("expected the request to go through the proxy")
+	}
+}
+
+func TestCACertPEM(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"stat": "OK", "response": {"user_id": "DUXXXXXXXXXXXXXXXXXX"}}`)
+	}))
+	t.Cleanup(server.Close)
+
+	config := Config{
+		IntegrationKey: "DIXXXXXXXXXXXXXXXXXX",
+		SecretKey:      "secret",
+		APIHostname:    strings.TrimPrefix(server.URL, "https://"),
+	}
+
+	c, err := New(config)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetUser(context.Background(), "DUXXXXXXXXXXXXXXXXXX"); err == nil {
+		t.Fatal("expected the server certificate to be rejected")
+	}
+
+	config.CACertPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	c, err = New(config)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := c.GetUser(context.Background(), "DUXXXXXXXXXXXXXXXXXX"); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestInvalidCACertPEM(t *testing.T) {
+	if _, err := New(Config{APIHostname: "duo.example.com", CACertPEM: []byte("not a certificate")}); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestPinnedRootCAs(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"stat": "OK", "response": {"user_id": "DUXXXXXXXXXXXXXXXXXX"}}`)
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := New(Config{
+		IntegrationKey: "DIXXXXXXXXXXXXXXXXXX",
+		SecretKey:      "secret",
+		APIHostname:    strings.TrimPrefix(server.URL, "https://"),
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	_, err = c.GetUser(context.Background(), "DUXXXXXXXXXXXXXXXXXX")
+	var unknownAuthority x509.UnknownAuthorityError
+	if !errors.As(err, &unknownAuthority) {
+		t.Fatalf("expected a certificate from an unpinned CA to be rejected, got %v", err)
+	}
+}
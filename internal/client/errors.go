@@ -0,0 +1,33 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Error is returned when Duo answers a request with a failure.
+type Error struct {
+	// StatusCode is the HTTP status of the response.
+	StatusCode int
+	// Code is the Duo error code, e.g. 40401.
+	Code int
+	// Message and MessageDetail describe the failure.
+	Message       string
+	MessageDetail string
+}
+
+func (e *Error) Error() string {
+	message := e.Message
+	if e.MessageDetail != "" {
+		message = fmt.Sprintf("%s: %s", message, e.MessageDetail)
+	}
+	return fmt.Sprintf("%s (HTTP %d, code %d)", message, e.StatusCode, e.Code)
+}
+
+// IsNotFound reports whether err means that the requested object does not
+// exist.
+func IsNotFound(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusNotFound
+}
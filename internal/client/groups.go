@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/duosecurity/duo_api_golang/admin"
+)
+
+// GroupUser models a member of a group.
+type GroupUser struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// CreateGroup calls POST /admin/v1/groups.
+func (c *Client) CreateGroup(ctx context.Context, params url.Values) (*admin.Group, error) {
+	group := &admin.Group{}
+	if _, err := c.call(ctx, http.MethodPost, "/admin/v1/groups", params, group); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// GetGroup calls GET /admin/v1/groups/:group_id.
+func (c *Client) GetGroup(ctx context.Context, groupID string) (*admin.Group, error) {
+	group := &admin.Group{}
+	if _, err := c.call(ctx, http.MethodGet, fmt.Sprintf("/admin/v1/groups/%s", groupID), nil, group); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// GetGroups calls GET /admin/v1/groups and returns every page.
+func (c *Client) GetGroups(ctx context.Context) ([]admin.Group, error) {
+	return list[admin.Group](ctx, c, "/admin/v1/groups", nil, 100)
+}
+
+// UpdateGroup calls POST /admin/v1/groups/:group_id.
+func (c *Client) UpdateGroup(ctx context.Context, groupID string, params url.Values) (*admin.Group, error) {
+	group := &admin.Group{}
+	if _, err := c.callRepeatable(ctx, http.MethodPost, fmt.Sprintf("/admin/v1/groups/%s", groupID), params, group); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// DeleteGroup calls DELETE /admin/v1/groups/:group_id.
+func (c *Client) DeleteGroup(ctx context.Context, groupID string) error {
+	_, err := c.call(ctx, http.MethodDelete, fmt.Sprintf("/admin/v1/groups/%s", groupID), nil, nil)
+	return err
+}
+
+// GetGroupUsers calls GET /admin/v2/groups/:group_id/users and returns every
+// page.
+func (c *Client) GetGroupUsers(ctx context.Context, groupID string) ([]GroupUser, error) {
+	return list[GroupUser](ctx, c, fmt.Sprintf("/admin/v2/groups/%s/users", groupID), nil, 500)
+}
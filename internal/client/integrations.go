@@ -0,0 +1,96 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Flag decodes permission flags, which Duo returns either as a boolean or as
+// the integers 0 and 1.
+type Flag bool
+
+func (f *Flag) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case "true", "1", `"1"`:
+		*f = true
+	case "false", "0", `"0"`, "null":
+		*f = false
+	default:
+		return fmt.Errorf("unexpected flag value: %s", data)
+	}
+	return nil
+}
+
+// Integration models an application integration.
+type Integration struct {
+	IntegrationKey        string   `json:"integration_key"`
+	SecretKey             string   `json:"secret_key"`
+	Name                  string   `json:"name"`
+	Type                  string   `json:"type"`
+	Notes                 string   `json:"notes"`
+	Greeting              string   `json:"greeting"`
+	GroupsAllowed         []string `json:"groups_allowed"`
+	AdminAPIAdmins        Flag     `json:"adminapi_admins"`
+	AdminAPIInfo          Flag     `json:"adminapi_info"`
+	AdminAPIIntegrations  Flag     `json:"adminapi_integrations"`
+	AdminAPIReadLog       Flag     `json:"adminapi_read_log"`
+	AdminAPIReadResource  Flag     `json:"adminapi_read_resource"`
+	AdminAPISettings      Flag     `json:"adminapi_settings"`
+	AdminAPIWriteResource Flag     `json:"adminapi_write_resource"`
+	NetworksForAPIAccess  string   `json:"networks_for_api_access"`
+	SelfServiceAllowed    Flag     `json:"self_service_allowed"`
+	PolicyKey             string   `json:"policy_key"`
+}
+
+// CreateIntegration calls POST /admin/v1/integrations.
+func (c *Client) CreateIntegration(ctx context.Context, params url.Values) (*Integration, error) {
+	integration := &Integration{}
+	if _, err := c.call(ctx, http.MethodPost, "/admin/v1/integrations", params, integration); err != nil {
+		return nil, err
+	}
+	return integration, nil
+}
+
+// GetIntegration calls GET /admin/v1/integrations/:integration_key.
+func (c *Client) GetIntegration(ctx context.Context, integrationKey string) (*Integration, error) {
+	integration := &Integration{}
+	if _, err := c.call(ctx, http.MethodGet, fmt.Sprintf("/admin/v1/integrations/%s", integrationKey), nil, integration); err != nil {
+		return nil, err
+	}
+	return integration, nil
+}
+
+// GetIntegrations calls GET /admin/v1/integrations and returns every page.
+func (c *Client) GetIntegrations(ctx context.Context) ([]Integration, error) {
+	return list[Integration](ctx, c, "/admin/v1/integrations", nil, 300)
+}
+
+// UpdateIntegration calls POST /admin/v1/integrations/:integration_key.
+func (c *Client) UpdateIntegration(ctx context.Context, integrationKey string, params url.Values) (*Integration, error) {
+	integration := &Integration{}
+	if _, err := c.callRepeatable(ctx, http.MethodPost, fmt.Sprintf("/admin/v1/integrations/%s", integrationKey), params, integration); err != nil {
+		return nil, err
+	}
+	return integration, nil
+}
+
+// ResetIntegrationSecretKey calls POST /admin/v1/integrations/:integration_key
+// with reset_secret_key, and returns the integration with its new secret key.
+func (c *Client) ResetIntegrationSecretKey(ctx context.Context, integrationKey string) (*Integration, error) {
+	params := url.Values{}
+	params.Set("reset_secret_key", "1")
+
+	integration := &Integration{}
+	if _, err := c.call(ctx, http.MethodPost, fmt.Sprintf("/admin/v1/integrations/%s", integrationKey), params, integration); err != nil {
+		return nil, err
+	}
+	return integration, nil
+}
+
+// DeleteIntegration calls DELETE /admin/v1/integrations/:integration_key.
+func (c *Client) DeleteIntegration(ctx context.Context, integrationKey string) error {
+	_, err := c.call(ctx, http.MethodDelete, fmt.Sprintf("/admin/v1/integrations/%s", integrationKey), nil, nil)
+	return err
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/duosecurity/duo_api_golang/admin"
+)
+
+// PhoneActivation models the response of the activation_url and
+// send_sms_activation calls.
+type PhoneActivation struct {
+	ActivationBarcode string `json:"activation_barcode"`
+	ActivationURL     string `json:"activation_url"`
+	InstallationURL   string `json:"installation_url"`
+	ValidSecs         int    `json:"valid_secs"`
+}
+
+// CreatePhone calls POST /admin/v1/phones.
+func (c *Client) CreatePhone(ctx context.Context, params url.Values) (*admin.Phone, error) {
+	phone := &admin.Phone{}
+	if _, err := c.call(ctx, http.MethodPost, "/admin/v1/phones", params, phone); err != nil {
+		return nil, err
+	}
+	return phone, nil
+}
+
+// GetPhone calls GET /admin/v1/phones/:phone_id.
+func (c *Client) GetPhone(ctx context.Context, phoneID string) (*admin.Phone, error) {
+	phone := &admin.Phone{}
+	if _, err := c.call(ctx, http.MethodGet, fmt.Sprintf("/admin/v1/phones/%s", phoneID), nil, phone); err != nil {
+		return nil, err
+	}
+	return phone, nil
+}
+
+// UpdatePhone calls POST /admin/v1/phones/:phone_id.
+func (c *Client) UpdatePhone(ctx context.Context, phoneID string, params url.Values) (*admin.Phone, error) {
+	phone := &admin.Phone{}
+	if _, err := c.callRepeatable(ctx, http.MethodPost, fmt.Sprintf("/admin/v1/phones/%s", phoneID), params, phone); err != nil {
+		return nil, err
+	}
+	return phone, nil
+}
+
+// DeletePhone calls DELETE /admin/v1/phones/:phone_id.
+func (c *Client) DeletePhone(ctx context.Context, phoneID string) error {
+	_, err := c.call(ctx, http.MethodDelete, fmt.Sprintf("/admin/v1/phones/%s", phoneID), nil, nil)
+	return err
+}
+
+// CreatePhoneActivationURL calls POST /admin/v1/phones/:phone_id/activation_url.
+func (c *Client) CreatePhoneActivationURL(ctx context.Context, phoneID string, params url.Values) (*PhoneActivation, error) {
+	activation := &PhoneActivation{}
+	if _, err := c.call(ctx, http.MethodPost, fmt.Sprintf("/admin/v1/phones/%s/activation_url", phoneID), params, activation); err != nil {
+		return nil, err
+	}
+	return activation, nil
+}
+
+// SendPhoneSMSActivation calls POST /admin/v1/phones/:phone_id/send_sms_activation.
+func (c *Client) SendPhoneSMSActivation(ctx context.Context, phoneID string, params url.Values) (*PhoneActivation, error) {
+	activation := &PhoneActivation{}
+	if _, err := c.call(ctx, http.MethodPost, fmt.Sprintf("/admin/v1/phones/%s/send_sms_activation", phoneID), params, activation); err != nil {
+		return nil, err
+	}
+	return activation, nil
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Policy models a Policies v2 policy.
+type Policy struct {
+	PolicyKey      string         `json:"policy_key"`
+	PolicyName     string         `json:"policy_name"`
+	IsGlobalPolicy bool           `json:"is_global_policy"`
+	Sections       map[string]any `json:"sections"`
+}
+
+// CreatePolicy calls POST /admin/v2/policies.
+func (c *Client) CreatePolicy(ctx context.Context, params map[string]any) (*Policy, error) {
+	policy := &Policy{}
+	if _, err := c.jsonCall(ctx, http.MethodPost, "/admin/v2/policies", params, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// GetPolicy calls GET /admin/v2/policies/:policy_key.
+func (c *Client) GetPolicy(ctx context.Context, policyKey string) (*Policy, error) {
+	policy := &Policy{}
+	if _, err := c.jsonCall(ctx, http.MethodGet, fmt.Sprintf("/admin/v2/policies/%s", policyKey), nil, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// GetGlobalPolicy calls GET /admin/v2/policies/global.
+func (c *Client) GetGlobalPolicy(ctx context.Context) (*Policy, error) {
+	return c.GetPolicy(ctx, "global")
+}
+
+// UpdatePolicy calls PUT /admin/v2/policies/:policy_key.
+func (c *Client) UpdatePolicy(ctx context.Context, policyKey string, params map[string]any) (*Policy, error) {
+	policy := &Policy{}
+	if _, err := c.jsonCall(ctx, http.MethodPut, fmt.Sprintf("/admin/v2/policies/%s", policyKey), params, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// DeletePolicy calls DELETE /admin/v2/policies/:policy_key.
+func (c *Client) DeletePolicy(ctx context.Context, policyKey string) error {
+	_, err := c.jsonCall(ctx, http.MethodDelete, fmt.Sprintf("/admin/v2/policies/%s", policyKey), nil, nil)
+	return err
+}
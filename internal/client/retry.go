@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// rateLimitCode is the Duo error code of rate limited requests.
+const rateLimitCode = 42901
+
+// retryBaseWait is the wait before the first retry; it doubles on every
+// following retry, up to Config.RetryMaxWait.
+const retryBaseWait = time.Second
+
+// isRateLimited reports whether err means that Duo rejected the request
+// because of rate limiting, in which case it was not processed.
+func isRateLimited(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && (e.StatusCode == http.StatusTooManyRequests || e.Code == rateLimitCode)
+}
+
+// retryWait returns how long to wait before retrying a request for the
+// attempt-th time (starting at 0). A Retry-After header takes precedence over
+// the exponential backoff; both are capped at max.
+func retryWait(attempt int, retryAfter string, max time.Duration) time.Duration {
+	if wait, ok := parseRetryAfter(retryAfter); ok {
+		if wait > max {
+			return max
+		}
+		return wait
+	}
+
+	wait := max
+	if attempt < 32 && retryBaseWait<<attempt < max {
+		wait = retryBaseWait << attempt
+	}
+
+	// Spread retries of concurrent requests over the second half of the wait.
+	half := wait / 2
+	if half <= 0 {
+		return wait
+	}
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// parseRetryAfter decodes a Retry-After header, given either in seconds or as
+// an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		wait := time.Until(t)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// sleep waits for d, or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,29 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Settings are the account settings. They are decoded loosely since Duo adds
+// new settings over time.
+type Settings map[string]any
+
+// GetSettings calls GET /admin/v1/settings.
+func (c *Client) GetSettings(ctx context.Context) (Settings, error) {
+	settings := Settings{}
+	if _, err := c.call(ctx, http.MethodGet, "/admin/v1/settings", nil, &settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// UpdateSettings calls POST /admin/v1/settings.
+func (c *Client) UpdateSettings(ctx context.Context, params url.Values) (Settings, error) {
+	settings := Settings{}
+	if _, err := c.callRepeatable(ctx, http.MethodPost, "/admin/v1/settings", params, &settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
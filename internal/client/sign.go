@@ -0,0 +1,61 @@
+package client
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// canonParams encodes params the way Duo expects them when signing: sorted,
+// with spaces escaped as %20.
+func canonParams(params url.Values) string {
+	for _, values := range params {
+		sort.Strings(values)
+	}
+	return strings.ReplaceAll(params.Encode(), "+", "%20")
+}
+
+// sign returns the Authorization header of a request with form parameters
+// (signature version 2).
+func sign(integrationKey, secretKey, date, method, host, path string, params url.Values) string {
+	canon := strings.Join([]string{
+		date,
+		strings.ToUpper(method),
+		strings.ToLower(host),
+		path,
+		canonParams(params),
+	}, "\n")
+	return authorization(integrationKey, secretKey, canon)
+}
+
+// signJSON returns the Authorization header of a request with a JSON body
+// (signature version 5).
+func signJSON(integrationKey, secretKey, date, method, host, path string, params url.Values, body string) string {
+	canon := strings.Join([]string{
+		date,
+		strings.ToUpper(method),
+		strings.ToLower(host),
+		path,
+		canonParams(params),
+		hashString(body),
+		// Duo does not require any additional x-duo headers to be signed.
+		hashString(""),
+	}, "\n")
+	return authorization(integrationKey, secretKey, canon)
+}
+
+func hashString(s string) string {
+	sum := sha512.Sum512([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func authorization(integrationKey, secretKey, canon string) string {
+	mac := hmac.New(sha512.New, []byte(secretKey))
+	mac.Write([]byte(canon))
+	auth := integrationKey + ":" + hex.EncodeToString(mac.Sum(nil))
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(auth))
+}
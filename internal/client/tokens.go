@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/duosecurity/duo_api_golang/admin"
+)
+
+// CreateToken calls POST /admin/v1/tokens.
+func (c *Client) CreateToken(ctx context.Context, params url.Values) (*admin.Token, error) {
+	token := &admin.Token{}
+	if _, err := c.call(ctx, http.MethodPost, "/admin/v1/tokens", params, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// GetToken calls GET /admin/v1/tokens/:token_id.
+func (c *Client) GetToken(ctx context.Context, tokenID string) (*admin.Token, error) {
+	token := &admin.Token{}
+	if _, err := c.call(ctx, http.MethodGet, fmt.Sprintf("/admin/v1/tokens/%s", tokenID), nil, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// DeleteToken calls DELETE /admin/v1/tokens/:token_id.
+func (c *Client) DeleteToken(ctx context.Context, tokenID string) error {
+	_, err := c.call(ctx, http.MethodDelete, fmt.Sprintf("/admin/v1/tokens/%s", tokenID), nil, nil)
+	return err
+}
+
+// ResyncToken calls POST /admin/v1/tokens/:token_id/resync.
+func (c *Client) ResyncToken(ctx context.Context, tokenID string, params url.Values) error {
+	_, err := c.call(ctx, http.MethodPost, fmt.Sprintf("/admin/v1/tokens/%s/resync", tokenID), params, nil)
+	return err
+}
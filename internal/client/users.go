@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/duosecurity/duo_api_golang/admin"
+)
+
+// CreateUser calls POST /admin/v1/users.
+func (c *Client) CreateUser(ctx context.Context, params url.Values) (*admin.User, error) {
+	user := &admin.User{}
+	if _, err := c.call(ctx, http.MethodPost, "/admin/v1/users", params, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// GetUser calls GET /admin/v1/users/:user_id.
+func (c *Client) GetUser(ctx context.Context, userID string) (*admin.User, error) {
+	user := &admin.User{}
+	if _, err := c.call(ctx, http.MethodGet, fmt.Sprintf("/admin/v1/users/%s", userID), nil, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// GetUsers calls GET /admin/v1/users, which params may filter by username or
+// email, and returns every page.
+func (c *Client) GetUsers(ctx context.Context, params url.Values) ([]admin.User, error) {
+	return list[admin.User](ctx, c, "/admin/v1/users", params, 300)
+}
+
+// UpdateUser calls POST /admin/v1/users/:user_id.
+func (c *Client) UpdateUser(ctx context.Context, userID string, params url.Values) (*admin.User, error) {
+	user := &admin.User{}
+	if _, err := c.callRepeatable(ctx, http.MethodPost, fmt.Sprintf("/admin/v1/users/%s", userID), params, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// DeleteUser calls DELETE /admin/v1/users/:user_id.
+func (c *Client) DeleteUser(ctx context.Context, userID string) error {
+	_, err := c.call(ctx, http.MethodDelete, fmt.Sprintf("/admin/v1/users/%s", userID), nil, nil)
+	return err
+}
+
+// GetUserGroups calls GET /admin/v1/users/:user_id/groups and returns every
+// page.
+func (c *Client) GetUserGroups(ctx context.Context, userID string) ([]admin.Group, error) {
+	return list[admin.Group](ctx, c, fmt.Sprintf("/admin/v1/users/%s/groups", userID), nil, 500)
+}
+
+// AddUserGroup calls POST /admin/v1/users/:user_id/groups.
+func (c *Client) AddUserGroup(ctx context.Context, userID, groupID string) error {
+	params := url.Values{}
+	params.Set("group_id", groupID)
+
+	_, err := c.callRepeatable(ctx, http.MethodPost, fmt.Sprintf("/admin/v1/users/%s/groups", userID), params, nil)
+	return err
+}
+
+// RemoveUserGroup calls DELETE /admin/v1/users/:user_id/groups/:group_id.
+func (c *Client) RemoveUserGroup(ctx context.Context, userID, groupID string) error {
+	_, err := c.call(ctx, http.MethodDelete, fmt.Sprintf("/admin/v1/users/%s/groups/%s", userID, groupID), nil, nil)
+	return err
+}
+
+// GetUserPhones calls GET /admin/v1/users/:user_id/phones and returns every
+// page.
+func (c *Client) GetUserPhones(ctx context.Context, userID string) ([]admin.Phone, error) {
+	return list[admin.Phone](ctx, c, fmt.Sprintf("/admin/v1/users/%s/phones", userID), nil, 500)
+}
+
+// AddUserPhone calls POST /admin/v1/users/:user_id/phones.
+func (c *Client) AddUserPhone(ctx context.Context, userID, phoneID string) error {
+	params := url.Values{}
+	params.Set("phone_id", phoneID)
+
+	_, err := c.callRepeatable(ctx, http.MethodPost, fmt.Sprintf("/admin/v1/users/%s/phones", userID), params, nil)
+	return err
+}
+
+// RemoveUserPhone calls DELETE /admin/v1/users/:user_id/phones/:phone_id.
+func (c *Client) RemoveUserPhone(ctx context.Context, userID, phoneID string) error {
+	_, err := c.call(ctx, http.MethodDelete, fmt.Sprintf("/admin/v1/users/%s/phones/%s", userID, phoneID), nil, nil)
+	return err
+}
+
+// GetUserTokens calls GET /admin/v1/users/:user_id/tokens and returns every
+// page.
+func (c *Client) GetUserTokens(ctx context.Context, userID string) ([]admin.Token, error) {
+	return list[admin.Token](ctx, c, fmt.Sprintf("/admin/v1/users/%s/tokens", userID), nil, 500)
+}
+
+// AddUserToken calls POST /admin/v1/users/:user_id/tokens.
+func (c *Client) AddUserToken(ctx context.Context, userID, tokenID string) error {
+	params := url.Values{}
+	params.Set("token_id", tokenID)
+
+	_, err := c.callRepeatable(ctx, http.MethodPost, fmt.Sprintf("/admin/v1/users/%s/tokens", userID), params, nil)
+	return err
+}
+
+// RemoveUserToken calls DELETE /admin/v1/users/:user_id/tokens/:token_id.
+func (c *Client) RemoveUserToken(ctx context.Context, userID, tokenID string) error {
+	_, err := c.call(ctx, http.MethodDelete, fmt.Sprintf("/admin/v1/users/%s/tokens/%s", userID, tokenID), nil, nil)
+	return err
+}
+
+// CreateUserBypassCodes calls POST /admin/v1/users/:user_id/bypass_codes,
+// which replaces every existing bypass code of the user, and returns the new
+// codes.
+func (c *Client) CreateUserBypassCodes(ctx context.Context, userID string, params url.Values) ([]string, error) {
+	codes := []string{}
+	if _, err := c.call(ctx, http.MethodPost, fmt.Sprintf("/admin/v1/users/%s/bypass_codes", userID), params, &codes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// GetUserBypassCodes calls GET /admin/v1/users/:user_id/bypass_codes and
+// returns every page.
+func (c *Client) GetUserBypassCodes(ctx context.Context, userID string) ([]BypassCode, error) {
+	return list[BypassCode](ctx, c, fmt.Sprintf("/admin/v1/users/%s/bypass_codes", userID), nil, 500)
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// compositeIdSeparator separates the parts of the ID of association
+// resources. Duo IDs and integration keys are alphanumeric, so it can never
+// appear inside a part.
+const compositeIdSeparator = ":"
+
+// legacyCompositeIdSeparator is the separator used before schema version 1.
+const legacyCompositeIdSeparator = "-"
+
+// buildCompositeId joins the parts of an association ID.
+func buildCompositeId(parts ...string) string {
+	return strings.Join(parts, compositeIdSeparator)
+}
+
+// parseCompositeId splits an association ID into one part per name, e.g.
+// parseCompositeId(id, "group_id", "user_id").
+func parseCompositeId(id string, names ...string) ([]string, error) {
+	parts := strings.Split(id, compositeIdSeparator)
+	if len(parts) != len(names) {
+		return nil, compositeIdError(id, names)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, compositeIdError(id, names)
+		}
+	}
+	return parts, nil
+}
+
+func compositeIdError(id string, names []string) error {
+	return fmt.Errorf("unexpected format of ID (%q), expected <%s>", id, strings.Join(names, ">"+compositeIdSeparator+"<"))
+}
+
+// importCompositeId returns an importer that validates the ID and sets the
+// attribute of each part, e.g. importCompositeId("group_id", "user_id").
+func importCompositeId(names ...string) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+			parts, err := parseCompositeId(d.Id(), names...)
+			if err != nil {
+				return nil, err
+			}
+			for i, name := range names {
+				d.Set(name, parts[i])
+			}
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// compositeIdStateUpgraderV0 migrates the ID of an association resource from
+// the legacy separator to compositeIdSeparator. names are the attributes of
+// the association, in ID order.
+func compositeIdStateUpgraderV0(names ...string) schema.StateUpgrader {
+	attributes := map[string]cty.Type{"id": cty.String}
+	for _, name := range names {
+		attributes[name] = cty.String
+	}
+
+	return schema.StateUpgrader{
+		Version: 0,
+		Type:    cty.Object(attributes),
+		Upgrade: func(ctx context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+			id, _ := rawState["id"].(string)
+			if _, err := parseCompositeId(id, names...); err == nil {
+				return rawState, nil
+			}
+
+			parts := strings.Split(id, legacyCompositeIdSeparator)
+			if len(parts) != len(names) {
+				return nil, fmt.Errorf("unable to migrate ID (%q), expected <%s>", id, strings.Join(names, ">"+legacyCompositeIdSeparator+"<"))
+			}
+			rawState["id"] = buildCompositeId(parts...)
+
+			return rawState, nil
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestParseCompositeId(t *testing.T) {
+	parts, err := parseCompositeId("DGXXXXXXXXXXXXXXXXXX:DUXXXXXXXXXXXXXXXXXX", "group_id", "user_id")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if parts[0] != "DGXXXXXXXXXXXXXXXXXX" || parts[1] != "DUXXXXXXXXXXXXXXXXXX" {
+		t.Fatalf("unexpected parts: %v", parts)
+	}
+
+	for _, id := range []string{"", "DGXXXXXXXXXXXXXXXXXX", "DGXXXXXXXXXXXXXXXXXX-DUXXXXXXXXXXXXXXXXXX", ":DUXXXXXXXXXXXXXXXXXX", "DG:DU:DP"} {
+		if _, err := parseCompositeId(id, "group_id", "user_id"); err == nil {
+			t.Errorf("expected an error for ID %q", id)
+		}
+	}
+}
+
+func TestCompositeIdStateUpgraderV0(t *testing.T) {
+	upgrader := compositeIdStateUpgraderV0("group_id", "user_id")
+
+	for id, expected := range map[string]string{
+		"DGXXXXXXXXXXXXXXXXXX-DUXXXXXXXXXXXXXXXXXX": "DGXXXXXXXXXXXXXXXXXX:DUXXXXXXXXXXXXXXXXXX",
+		"DGXXXXXXXXXXXXXXXXXX:DUXXXXXXXXXXXXXXXXXX": "DGXXXXXXXXXXXXXXXXXX:DUXXXXXXXXXXXXXXXXXX",
+	} {
+		state, err := upgrader.Upgrade(context.Background(), map[string]any{"id": id}, nil)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if state["id"] != expected {
+			t.Errorf("expected %q, got %q", expected, state["id"])
+		}
+	}
+
+	if _, err := upgrader.Upgrade(context.Background(), map[string]any{"id": "DGXXXXXXXXXXXXXXXXXX"}, nil); err == nil {
+		t.Error("expected an error for a malformed ID")
+	}
+}
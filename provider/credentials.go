@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultCredentialsFile is read when no credentials file is configured.
+const defaultCredentialsFile = "~/.duo/credentials"
+
+// defaultProfile is used when no profile is selected.
+const defaultProfile = "default"
+
+// credentials holds the settings of a named profile of a credentials file.
+type credentials struct {
+	IntegrationKey string `json:"integration_key"`
+	SecretKey      string `json:"secret_key"`
+	APIHostname    string `json:"api_hostname"`
+}
+
+// resolveCredentials returns the credentials set in the provider block or
+// the environment, completed from the selected profile of the credentials
+// file. The default profile is only used if it exists.
+func resolveCredentials(d *schema.ResourceData) (credentials, diag.Diagnostics) {
+	creds := credentials{
+		IntegrationKey: d.Get("integration_key").(string),
+		SecretKey:      d.Get("secret_key").(string),
+		APIHostname:    d.Get("api_hostname").(string),
+	}
+
+	profile := d.Get("profile").(string)
+	credentials_file := d.Get("credentials_file").(string)
+	if profile != "" || creds.IntegrationKey == "" || creds.SecretKey == "" || creds.APIHostname == "" {
+		name := profile
+		if name == "" {
+			name = defaultProfile
+		}
+
+		fromProfile, err := loadProfile(credentials_file, name)
+		if err != nil {
+			return creds, diag.Errorf("Unable to read credentials file: %s", err)
+		}
+		if fromProfile == nil && profile != "" {
+			return creds, diag.Errorf("Unable to find profile %q in %s", profile, credentials_file)
+		}
+
+		if fromProfile != nil {
+			if creds.IntegrationKey == "" {
+				creds.IntegrationKey = fromProfile.IntegrationKey
+			}
+			if creds.SecretKey == "" {
+				creds.SecretKey = fromProfile.SecretKey
+			}
+			if creds.APIHostname == "" {
+				creds.APIHostname = fromProfile.APIHostname
+			}
+		}
+	}
+
+	if creds.IntegrationKey == "" || creds.SecretKey == "" {
+		return creds, diag.Errorf("Missing Duo credentials: set integration_key and secret_key, the DUO_INTEGRATION_KEY and DUO_SECRET_KEY environment variables, or a profile")
+	}
+
+	return creds, nil
+}
+
+// loadProfile reads a profile from a credentials file, which is either an
+// INI file with one section per profile:
+//
+//	[default]
+//	integration_key = DIXXXXXXXXXXXXXXXXXX
+//	secret_key = xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
+//	api_hostname = api-XXXXXXXX.duosecurity.com
+//
+// or a JSON object keyed by profile name. It returns nil if the file or the
+// profile does not exist.
+func loadProfile(path, profile string) (*credentials, error) {
+	path, err := expandHome(path)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles map[string]*credentials
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		err = json.Unmarshal(data, &profiles)
+	} else {
+		profiles, err = parseCredentialsINI(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %s", path, err)
+	}
+
+	return profiles[profile], nil
+}
+
+func parseCredentialsINI(data []byte) (map[string]*credentials, error) {
+	profiles := map[string]*credentials{}
+
+	var current *credentials
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]") {
+			name := strings.TrimSpace(text[1 : len(text)-1])
+			current = &credentials{}
+			profiles[name] = current
+			continue
+		}
+
+		key, value, ok := strings.Cut(text, "=")
+		if !ok || current == nil {
+			return nil, fmt.Errorf("line %d: expected a [profile] header or a key = value pair", line)
+		}
+
+		value = strings.TrimSpace(value)
+		switch strings.TrimSpace(key) {
+		case "integration_key":
+			current.IntegrationKey = value
+		case "secret_key":
+			current.SecretKey = value
+		case "api_hostname":
+			current.APIHostname = value
+		}
+	}
+
+	return profiles, scanner.Err()
+}
+
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, path[1:]), nil
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const testCredentialsINI = `
+# Duo accounts
+[default]
+integration_key = DIDEFAULTXXXXXXXXXXX
+secret_key = default-secret
+api_hostname = api-default.duosecurity.com
+
+[staging]
+integration_key=DISTAGINGXXXXXXXXXXX
+secret_key=staging-secret
+api_hostname=api-staging.duosecurity.com
+`
+
+const testCredentialsJSON = `{
+	"default": {
+		"integration_key": "DIDEFAULTXXXXXXXXXXX",
+		"secret_key": "default-secret",
+		"api_hostname": "api-default.duosecurity.com"
+	},
+	"staging": {
+		"integration_key": "DISTAGINGXXXXXXXXXXX",
+		"secret_key": "staging-secret",
+		"api_hostname": "api-staging.duosecurity.com"
+	}
+}`
+
+func writeCredentialsFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return path
+}
+
+func TestLoadProfile(t *testing.T) {
+	for name, content := range map[string]string{"ini": testCredentialsINI, "json": testCredentialsJSON} {
+		path := writeCredentialsFile(t, content)
+
+		creds, err := loadProfile(path, "staging")
+		if err != nil {
+			t.Fatalf("%s: err: %s", name, err)
+		}
+		expected := credentials{
+			IntegrationKey: "DISTAGINGXXXXXXXXXXX",
+			SecretKey:      "staging-secret",
+			APIHostname:    "api-staging.duosecurity.com",
+		}
+		if creds == nil || *creds != expected {
+			t.Errorf("%s: expected %+v, got %+v", name, expected, creds)
+		}
+
+		if creds, err := loadProfile(path, "production"); err != nil || creds != nil {
+			t.Errorf("%s: expected no profile, got %+v, %v", name, creds, err)
+		}
+	}
+}
+
+func TestLoadProfileMissingFile(t *testing.T) {
+	creds, err := loadProfile(filepath.Join(t.TempDir(), "credentials"), defaultProfile)
+	if err != nil || creds != nil {
+		t.Errorf("expected no profile, got %+v, %v", creds, err)
+	}
+}
+
+func TestLoadProfileInvalid(t *testing.T) {
+	for _, content := range []string{"integration_key = DIXXXXXXXXXXXXXXXXXX", "[default]\nintegration_key", `{"default": []}`} {
+		if _, err := loadProfile(writeCredentialsFile(t, content), defaultProfile); err == nil {
+			t.Errorf("expected an error for %q", content)
+		}
+	}
+}
+
+func TestResolveCredentials(t *testing.T) {
+	path := writeCredentialsFile(t, testCredentialsINI)
+
+	t.Setenv("DUO_INTEGRATION_KEY", "")
+	t.Setenv("DUO_SECRET_KEY", "env-secret")
+	t.Setenv("DUO_API_HOSTNAME", "api-env.duosecurity.com")
+	t.Setenv("DUO_PROFILE", "staging")
+
+	d := schema.TestResourceDataRaw(t, New("dev")().Schema, map[string]any{
+		"credentials_file": path,
+		"api_hostname":     "api-explicit.duosecurity.com",
+	})
+
+	creds, diags := resolveCredentials(d)
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	// Explicit configuration wins over the environment, which wins over the
+	// profile.
+	expected := credentials{
+		IntegrationKey: "DISTAGINGXXXXXXXXXXX",
+		SecretKey:      "env-secret",
+		APIHostname:    "api-explicit.duosecurity.com",
+	}
+	if creds != expected {
+		t.Errorf("expected %+v, got %+v", expected, creds)
+	}
+}
+
+func TestResolveCredentialsDefaultProfile(t *testing.T) {
+	t.Setenv("DUO_INTEGRATION_KEY", "")
+	t.Setenv("DUO_SECRET_KEY", "")
+	t.Setenv("DUO_API_HOSTNAME", "")
+	t.Setenv("DUO_PROFILE", "")
+
+	d := schema.TestResourceDataRaw(t, New("dev")().Schema, map[string]any{
+		"credentials_file": writeCredentialsFile(t, testCredentialsJSON),
+	})
+
+	creds, diags := resolveCredentials(d)
+	if diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if creds.IntegrationKey != "DIDEFAULTXXXXXXXXXXX" {
+		t.Errorf("expected the default profile, got %+v", creds)
+	}
+}
+
+func TestResolveCredentialsUnknownProfile(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, New("dev")().Schema, map[string]any{
+		"credentials_file": writeCredentialsFile(t, testCredentialsINI),
+		"profile":          "production",
+	})
+
+	if _, diags := resolveCredentials(d); !diags.HasError() {
+		t.Fatal("expected an error for an unknown profile")
+	}
+}
+
+func TestResolveCredentialsMissing(t *testing.T) {
+	t.Setenv("DUO_INTEGRATION_KEY", "")
+	t.Setenv("DUO_SECRET_KEY", "")
+	t.Setenv("DUO_PROFILE", "")
+
+	d := schema.TestResourceDataRaw(t, New("dev")().Schema, map[string]any{
+		"credentials_file": filepath.Join(t.TempDir(), "credentials"),
+	})
+
+	if _, diags := resolveCredentials(d); !diags.HasError() {
+		t.Fatal("expected an error for missing credentials")
+	}
+}
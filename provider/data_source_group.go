@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+func DataSourceGroup() *schema.Resource {
+	s := groupAttributes()
+	s["group_id"] = &schema.Schema{
+		Description:  "The ID of the group to retrieve. Exactly one of `group_id` or `name` must be set.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"group_id", "name"},
+	}
+	s["name"] = &schema.Schema{
+		Description:  "The exact name of the group to retrieve.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"group_id", "name"},
+	}
+
+	return &schema.Resource{
+		Description: "Provides details about a specific Duo Group.",
+
+		ReadContext: DataSourceGroupRead,
+
+		Schema: s,
+	}
+}
+
+func DataSourceGroupRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	var group admin.Group
+
+	if v, ok := d.GetOk("group_id"); ok {
+		result, err := duoClient.GetGroup(ctx, v.(string))
+		if err != nil {
+			return diag.Errorf("Unable to read group: %s, error: %s", v.(string), err)
+		}
+		group = *result
+	} else {
+		name := d.Get("name").(string)
+
+		groups, err := duoClient.GetGroups(ctx)
+		if err != nil {
+			return diag.Errorf("Unable to read groups: %s", err)
+		}
+
+		matches := []admin.Group{}
+		for _, g := range groups {
+			if g.Name == name {
+				matches = append(matches, g)
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			return diag.Errorf("No group found with name %q", name)
+		case 1:
+			group = matches[0]
+		default:
+			return diag.Errorf("Found %d groups with name %q, expected exactly one", len(matches), name)
+		}
+	}
+
+	d.SetId(group.GroupID)
+	for k, v := range flattenGroup(group) {
+		d.Set(k, v)
+	}
+
+	return nil
+}
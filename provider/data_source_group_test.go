@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceGroup(t *testing.T) {
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGroup,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("duo_group.test", "id", "data.duo_group.by_id", "group_id"),
+					resource.TestCheckResourceAttrPair("duo_group.test", "id", "data.duo_group.by_name", "group_id"),
+					resource.TestCheckResourceAttrSet("data.duo_group.by_name", "push_enabled"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceGroup = `
+resource "duo_group" "test" {
+	name = "test-data-source-group"
+}
+
+data "duo_group" "by_id" {
+	group_id = duo_group.test.id
+}
+
+data "duo_group" "by_name" {
+	name = duo_group.test.name
+}
+`
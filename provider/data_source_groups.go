@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+// groupAttributes are the attributes describing a group, shared by the
+// duo_group and duo_groups data sources.
+func groupAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"group_id": {
+			Description: "The ID of the group.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "The name of the group.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"desc": {
+			Description: "The description of the group.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"status": {
+			Description: "The authentication status of the group.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"push_enabled": {
+			Description: "Whether users in the group can use Duo Push.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"sms_enabled": {
+			Description: "Whether users in the group can use SMS passcodes.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"voice_enabled": {
+			Description: "Whether users in the group can use phone callback.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"mobile_otp_enabled": {
+			Description: "Whether users in the group can use Duo Mobile passcodes.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+	}
+}
+
+// flattenGroup converts a group to the attributes of groupAttributes.
+func flattenGroup(group admin.Group) map[string]any {
+	return map[string]any{
+		"group_id":           group.GroupID,
+		"name":               group.Name,
+		"desc":               group.Desc,
+		"status":             group.Status,
+		"push_enabled":       group.PushEnabled,
+		"sms_enabled":        group.SMSEnabled,
+		"voice_enabled":      group.VoiceEnabled,
+		"mobile_otp_enabled": group.MobileOTPEnabled,
+	}
+}
+
+func DataSourceGroups() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a list of Duo Groups, optionally filtered by name prefix.",
+
+		ReadContext: DataSourceGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Description: "Only return groups whose name starts with this prefix.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"groups": {
+				Description: "The groups matching the filter.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: groupAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func DataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	groups, err := duoClient.GetGroups(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to read groups: %s", err)
+	}
+
+	name_prefix := d.Get("name_prefix").(string)
+
+	list := []map[string]any{}
+	for _, group := range groups {
+		if !strings.HasPrefix(group.Name, name_prefix) {
+			continue
+		}
+		list = append(list, flattenGroup(group))
+	}
+
+	// The ID only needs to be stable for a given prefix, but must not be
+	// empty when listing every group.
+	d.SetId("groups|" + name_prefix)
+	if err := d.Set("groups", list); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceGroups(t *testing.T) {
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGroups,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.duo_groups.test", "groups.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGroupsReadAll(t *testing.T) {
+	duoClient := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"stat": "OK", "response": [{"group_id": "DGXXXXXXXXXXXXXXXXX1", "name": "a"}, {"group_id": "DGXXXXXXXXXXXXXXXXX2", "name": "b"}], "metadata": {}}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, DataSourceGroups().Schema, map[string]any{})
+	if diags := DataSourceGroupsRead(context.Background(), d, duoClient); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	if d.Id() == "" {
+		t.Error("expected a non-empty ID without a name prefix")
+	}
+	if groups := d.Get("groups").([]any); len(groups) != 2 {
+		t.Errorf("expected 2 groups, got %d", len(groups))
+	}
+}
+
+const testAccDataSourceGroups = `
+resource "duo_group" "a" {
+	name = "test-data-source-groups-a"
+}
+
+resource "duo_group" "b" {
+	name = "test-data-source-groups-b"
+}
+
+data "duo_groups" "test" {
+	name_prefix = "test-data-source-groups-"
+
+	depends_on = [duo_group.a, duo_group.b]
+}
+`
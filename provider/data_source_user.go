@@ -2,11 +2,12 @@ package provider
 
 import (
 	"context"
+	"net/url"
 
-	duoapi "github.com/duosecurity/duo_api_golang"
 	"github.com/duosecurity/duo_api_golang/admin"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
 )
 
 func DataSourceUser() *schema.Resource {
@@ -17,14 +18,18 @@ func DataSourceUser() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"user_id": {
-				Description: "The ID of the user to retrieve.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "The ID of the user to retrieve. Exactly one of `user_id`, `username` or `email` must be set.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"user_id", "username", "email"},
 			},
 			"username": {
-				Description: "The name of the user to retrieve.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:  "The name of the user to retrieve.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"user_id", "username", "email"},
 			},
 			"realname": {
 				Description: "The real name (or full name) of this user.",
@@ -32,9 +37,11 @@ func DataSourceUser() *schema.Resource {
 				Computed:    true,
 			},
 			"email": {
-				Description: "The email address of this user.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:  "The email address of the user to retrieve.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"user_id", "username", "email"},
 			},
 			"status": {
 				Description: "The user's status. Must be one of: `active` `bypass` `disabled`.",
@@ -62,27 +69,43 @@ func DataSourceUser() *schema.Resource {
 
 func DataSourceUserRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 
-	duoClient := meta.(*duoapi.DuoApi)
-	duoAdminClient := admin.New(*duoClient)
+	duoClient := meta.(*client.Client)
 
-	user_id := d.Get("user_id").(string)
+	var user admin.User
 
-	result, err := duoAdminClient.GetUser(user_id)
+	if v, ok := d.GetOk("user_id"); ok {
+		result, err := duoClient.GetUser(ctx, v.(string))
+		if err != nil {
+			return diag.Errorf("Unable to read user: %s, error: %s", v.(string), err)
+		}
+		user = *result
+	} else {
+		values := url.Values{}
+		lookup := "username"
+		if v, ok := d.GetOk("email"); ok {
+			lookup = "email"
+			values.Set("email", v.(string))
+		} else {
+			values.Set("username", d.Get("username").(string))
+		}
 
-	if err != nil {
-		return diag.Errorf("An error has occurred: %s", err)
-	}
+		users, err := duoClient.GetUsers(ctx, values)
+		if err != nil {
+			return diag.Errorf("Unable to read user: %s, error: %s", values.Get(lookup), err)
+		}
 
-	if result.Stat != "OK" {
-		if *result.Message == "Resource not found" {
-			return diag.Errorf("Unable to read user: %s, error: %s", result.Stat, *result.Message)
+		switch len(users) {
+		case 0:
+			return diag.Errorf("No user found with %s %q", lookup, values.Get(lookup))
+		case 1:
+			user = users[0]
+		default:
+			return diag.Errorf("Found %d users with %s %q, expected exactly one", len(users), lookup, values.Get(lookup))
 		}
-		return diag.Errorf("Unable to read user: %s, error: %s", result.Stat, *result.Message)
 	}
 
-	user := result.Response
-
 	d.SetId(user.UserID)
+	d.Set("user_id", user.UserID)
 	d.Set("username", user.Username)
 	d.Set("realname", user.RealName)
 	d.Set("email", user.Email)
@@ -18,6 +18,18 @@ func TestAccDataSourceUser(t *testing.T) {
 					resource.TestCheckResourceAttrPair("duo_user.test", "username", "data.duo_user.test", "username"),
 				),
 			},
+			{
+				Config: testAccDataSourceUserByUsername,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("duo_user.test", "id", "data.duo_user.test", "user_id"),
+				),
+			},
+			{
+				Config: testAccDataSourceUserByEmail,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("duo_user.test", "id", "data.duo_user.test", "user_id"),
+				),
+			},
 		},
 	})
 }
@@ -31,3 +43,25 @@ data "duo_user" "test" {
 	user_id = duo_user.test.id
 }
 `
+
+const testAccDataSourceUserByUsername = `
+resource "duo_user" "test" {
+	username = "test@test.com"
+	email    = "test@test.com"
+}
+
+data "duo_user" "test" {
+	username = duo_user.test.username
+}
+`
+
+const testAccDataSourceUserByEmail = `
+resource "duo_user" "test" {
+	username = "test@test.com"
+	email    = "test@test.com"
+}
+
+data "duo_user" "test" {
+	email = duo_user.test.email
+}
+`
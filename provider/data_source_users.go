@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/duosecurity/duo_api_golang/admin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+func DataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a list of Duo Users, optionally filtered.",
+
+		ReadContext: DataSourceUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Description: "Only return the user with this username.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"email": {
+				Description: "Only return users with this email address.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"status": {
+				Description:  "Only return users with this status. Must be one of: `active` `bypass` `disabled` `locked out` `pending deletion`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"active", "bypass", "disabled", "locked out", "pending deletion"}, false),
+			},
+			"group_id": {
+				Description: "Only return users that are members of this group.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"is_enrolled": {
+				Description: "Only return users that are (or are not) enrolled.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"users": {
+				Description: "The users matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Description: "The ID of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"username": {
+							Description: "The name of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"realname": {
+							Description: "The real name (or full name) of this user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"email": {
+							Description: "The email address of this user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The user's status.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"notes": {
+							Description: "The notes field of the user.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"firstname": {
+							Description: "The user's given name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"lastname": {
+							Description: "The user's surname.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"is_enrolled": {
+							Description: "Whether the user has an authentication device enrolled.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"groups": {
+							Description: "The groups the user is a member of.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group_id": {
+										Description: "The ID of the group.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"name": {
+										Description: "The name of the group.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"phones": {
+							Description: "The phones of the user.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"phone_id": {
+										Description: "The ID of the phone.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"number": {
+										Description: "The phone number.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"name": {
+										Description: "The label of the phone.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"type": {
+										Description: "The type of phone.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"platform": {
+										Description: "The phone platform.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"activated": {
+										Description: "Whether Duo Mobile has been activated on this phone.",
+										Type:        schema.TypeBool,
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"tokens": {
+							Description: "The hardware tokens of the user.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"token_id": {
+										Description: "The ID of the hardware token.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"type": {
+										Description: "The type of hardware token.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"serial": {
+										Description: "The serial number of the hardware token.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	values := url.Values{}
+
+	if v, ok := d.GetOk("username"); ok {
+		values.Set("username", v.(string))
+	}
+
+	if v, ok := d.GetOk("email"); ok {
+		values.Set("email", v.(string))
+	}
+
+	users, err := duoClient.GetUsers(ctx, values)
+	if err != nil {
+		return diag.Errorf("Unable to read users: %s", err)
+	}
+
+	status, filter_status := d.GetOk("status")
+	group_id, filter_group := d.GetOk("group_id")
+	is_enrolled := d.GetRawConfig().GetAttr("is_enrolled")
+
+	list := []map[string]any{}
+	for _, user := range users {
+		if filter_status && !strings.EqualFold(user.Status, status.(string)) {
+			continue
+		}
+		if filter_group && !userInGroup(user, group_id.(string)) {
+			continue
+		}
+		if !is_enrolled.IsNull() && user.IsEnrolled != is_enrolled.True() {
+			continue
+		}
+		list = append(list, flattenUser(user))
+	}
+
+	enrolled := ""
+	if !is_enrolled.IsNull() {
+		enrolled = strconv.FormatBool(is_enrolled.True())
+	}
+
+	// The ID only needs to be stable for a given set of filters.
+	d.SetId(strings.Join([]string{
+		values.Get("username"),
+		values.Get("email"),
+		d.Get("status").(string),
+		d.Get("group_id").(string),
+		enrolled,
+	}, "|"))
+
+	if err := d.Set("users", list); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func userInGroup(user admin.User, group_id string) bool {
+	for _, group := range user.Groups {
+		if group.GroupID == group_id {
+			return true
+		}
+	}
+	return false
+}
+
+// flattenUser converts a user to the `users` list element.
+func flattenUser(user admin.User) map[string]any {
+	groups := make([]map[string]any, 0, len(user.Groups))
+	for _, group := range user.Groups {
+		groups = append(groups, map[string]any{
+			"group_id": group.GroupID,
+			"name":     group.Name,
+		})
+	}
+
+	phones := make([]map[string]any, 0, len(user.Phones))
+	for _, phone := range user.Phones {
+		phones = append(phones, map[string]any{
+			"phone_id":  phone.PhoneID,
+			"number":    phone.Number,
+			"name":      phone.Name,
+			"type":      phone.Type,
+			"platform":  phone.Platform,
+			"activated": phone.Activated,
+		})
+	}
+
+	tokens := make([]map[string]any, 0, len(user.Tokens))
+	for _, token := range user.Tokens {
+		tokens = append(tokens, map[string]any{
+			"token_id": token.TokenID,
+			"type":     token.Type,
+			"serial":   token.Serial,
+		})
+	}
+
+	return map[string]any{
+		"user_id":     user.UserID,
+		"username":    user.Username,
+		"realname":    stringValue(user.RealName),
+		"email":       user.Email,
+		"status":      user.Status,
+		"notes":       user.Notes,
+		"firstname":   stringValue(user.FirstName),
+		"lastname":    stringValue(user.LastName),
+		"is_enrolled": user.IsEnrolled,
+		"groups":      groups,
+		"phones":      phones,
+		"tokens":      tokens,
+	}
+}
+
+// stringValue dereferences an optional string returned by Duo.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
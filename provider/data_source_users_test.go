@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceUsers(t *testing.T) {
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceUsers,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.duo_users.test", "users.#", "1"),
+					resource.TestCheckResourceAttrPair("duo_user.test", "id", "data.duo_users.test", "users.0.user_id"),
+					resource.TestCheckResourceAttrPair("duo_group.test", "id", "data.duo_users.test", "users.0.groups.0.group_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceUsersStatus(t *testing.T) {
+	for status, valid := range map[string]bool{"active": true, "locked out": true, "actvie": false} {
+		diags := DataSourceUsers().Validate(terraform.NewResourceConfigRaw(map[string]any{"status": status}))
+		if diags.HasError() == valid {
+			t.Errorf("status %q: expected valid = %t, got %v", status, valid, diags)
+		}
+	}
+}
+
+const testAccDataSourceUsers = `
+resource "duo_user" "test" {
+	username = "test@test.com"
+}
+
+resource "duo_group" "test" {
+	name = "test"
+}
+
+resource "duo_user_group_association" "test" {
+	group_id = duo_group.test.id
+	user_id  = duo_user.test.id
+}
+
+data "duo_users" "test" {
+	username = duo_user.test.username
+	group_id = duo_user_group_association.test.group_id
+}
+`
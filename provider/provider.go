@@ -2,10 +2,13 @@ package provider
 
 import (
 	"context"
+	"os"
+	"time"
 
-	duoapi "github.com/duosecurity/duo_api_golang"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
 )
 
 func init() {
@@ -18,30 +21,119 @@ func New(version string) func() *schema.Provider {
 			Schema: map[string]*schema.Schema{
 				"integration_key": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					DefaultFunc: schema.EnvDefaultFunc("DUO_INTEGRATION_KEY", nil),
-					Description: "Duo Admin API Integration key",
+					Description: "Duo Admin API Integration key. Can also be read from a profile",
 				},
 				"secret_key": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					DefaultFunc: schema.EnvDefaultFunc("DUO_SECRET_KEY", nil),
-					Description: "Duo Admin API Secret skey",
+					Description: "Duo Admin API Secret skey. Can also be read from a profile",
 				},
 				"api_hostname": {
 					Type:        schema.TypeString,
-					Required:    true,
+					Optional:    true,
 					DefaultFunc: schema.EnvDefaultFunc("DUO_API_HOSTNAME", nil),
-					Description: "Duo Admin API Server hostname",
+					Description: "Duo Admin API Server hostname. Can also be read from a profile",
+				},
+				"profile": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("DUO_PROFILE", nil),
+					Description: "Name of the credentials file profile providing the credentials that are not set in the provider block or the environment. Defaults to `default` when that profile exists",
+				},
+				"credentials_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("DUO_CREDENTIALS_FILE", defaultCredentialsFile),
+					Description: "Path to an INI or JSON file of named credential profiles. Defaults to `~/.duo/credentials`",
+				},
+				"api_base_url": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("DUO_API_BASE_URL", nil),
+					Description: "URL overriding the scheme and host requests are sent to, e.g. `http://localhost:8080` for a local stand-in server. Requests are signed for the host of this URL",
+				},
+				"http_proxy": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "URL of the proxy requests are sent through. Defaults to the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables",
+				},
+				"ca_cert_file": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"ca_cert_pem"},
+					Description:   "Path to a PEM encoded bundle of certificates trusted in addition to the certificate authorities pinned for Duo, e.g. the CA of a TLS intercepting proxy",
+				},
+				"ca_cert_pem": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"ca_cert_file"},
+					Description:   "PEM encoded bundle of certificates trusted in addition to the certificate authorities pinned for Duo, e.g. the CA of a TLS intercepting proxy",
+				},
+				"request_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      60,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "Number of seconds after which a single request to Duo is abandoned. Set to `0` to only rely on resource timeouts",
+				},
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      5,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "Maximum number of times a request rate limited by Duo is retried",
+				},
+				"retry_max_wait": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      30,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "Maximum number of seconds to wait between two attempts of a rate limited request",
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.FloatAtLeast(0),
+					Description:  "Maximum number of requests per second sent to Duo, across all resources. Defaults to `0`, which disables the limit",
+				},
+				"max_concurrent_requests": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "Maximum number of requests sent to Duo at the same time, across all resources. Defaults to `0`, which disables the limit",
 				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
-				"duo_user": DataSourceUser(),
+				"duo_user":   DataSourceUser(),
+				"duo_users":  DataSourceUsers(),
+				"duo_group":  DataSourceGroup(),
+				"duo_groups": DataSourceGroups(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"duo_user":                   ResourceUser(),
-				"duo_group":                  ResourceGroup(),
-				"duo_user_group_association": ResourceUserGroupAssociation(),
+				"duo_user":                        ResourceUser(),
+				"duo_group":                       ResourceGroup(),
+				"duo_user_group_association":      ResourceUserGroupAssociation(),
+				"duo_phone":                       ResourcePhone(),
+				"duo_user_phone_association":      ResourceUserPhoneAssociation(),
+				"duo_phone_activation":            ResourcePhoneActivation(),
+				"duo_hardware_token":              ResourceHardwareToken(),
+				"duo_hardware_token_resync":       ResourceHardwareTokenResync(),
+				"duo_user_token_association":      ResourceUserTokenAssociation(),
+				"duo_bypass_codes":                ResourceBypassCodes(),
+				"duo_integration":                 ResourceIntegration(),
+				"duo_integration_secret_rotation": ResourceIntegrationSecretRotation(),
+				"duo_admin":                       ResourceAdmin(),
+				"duo_administrative_unit":         ResourceAdministrativeUnit(),
+				"duo_policy":                      ResourcePolicy(),
+				"duo_global_policy":               ResourceGlobalPolicy(),
+				"duo_settings":                    ResourceSettings(),
+				"duo_custom_branding":             ResourceCustomBranding(),
+				"duo_group_members":               ResourceGroupMembers(),
 			},
 		}
 
@@ -57,12 +149,39 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		user_agent := "terraform-provider-duo/" + version
 
 		// Get API client credential
-		integration_key := d.Get("integration_key").(string)
-		secret_key := d.Get("secret_key").(string)
-		api_hostname := d.Get("api_hostname").(string)
+		creds, diags := resolveCredentials(d)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		ca_cert_pem := []byte(d.Get("ca_cert_pem").(string))
+		if v, ok := d.GetOk("ca_cert_file"); ok {
+			pem, err := os.ReadFile(v.(string))
+			if err != nil {
+				return nil, diag.Errorf("Unable to read CA bundle: %s", err)
+			}
+			ca_cert_pem = pem
+		}
 
-		client := duoapi.NewDuoApi(integration_key, secret_key, api_hostname, user_agent)
+		duoClient, err := client.New(client.Config{
+			IntegrationKey: creds.IntegrationKey,
+			SecretKey:      creds.SecretKey,
+			APIHostname:    creds.APIHostname,
+			UserAgent:      user_agent,
+			BaseURL:        d.Get("api_base_url").(string),
+			HTTPProxy:      d.Get("http_proxy").(string),
+			CACertPEM:      ca_cert_pem,
+			RequestTimeout: time.Duration(d.Get("request_timeout").(int)) * time.Second,
+			MaxRetries:     d.Get("max_retries").(int),
+			RetryMaxWait:   time.Duration(d.Get("retry_max_wait").(int)) * time.Second,
+
+			RequestsPerSecond:     d.Get("requests_per_second").(float64),
+			MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+		})
+		if err != nil {
+			return nil, diag.Errorf("Unable to configure Duo client: %s", err)
+		}
 
-		return client, nil
+		return duoClient, nil
 	}
 }
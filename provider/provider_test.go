@@ -2,10 +2,13 @@ package provider
 
 import (
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
 )
 
 var ProviderFactories = map[string]func() (*schema.Provider, error){
@@ -20,6 +23,33 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+// newTestClient returns a client talking to a local server answering with
+// handler, for unit tests of resource functions.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *client.Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	duoClient, err := client.New(client.Config{
+		IntegrationKey: "DIXXXXXXXXXXXXXXXXXX",
+		SecretKey:      "secret",
+		BaseURL:        server.URL,
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return duoClient
+}
+
+// testAccClient returns a client for the account used by acceptance tests,
+// to check resources after they are destroyed.
+func testAccClient() (*client.Client, error) {
+	return client.New(client.Config{
+		IntegrationKey: os.Getenv("DUO_INTEGRATION_KEY"),
+		SecretKey:      os.Getenv("DUO_SECRET_KEY"),
+		APIHostname:    os.Getenv("DUO_API_HOSTNAME"),
+	})
+}
+
 func TestAccPreCheck(t *testing.T) {
 	err := accPreCheck()
 	if err != nil {
@@ -28,6 +58,9 @@ func TestAccPreCheck(t *testing.T) {
 }
 
 func accPreCheck() error {
+	if v := os.Getenv("DUO_PROFILE"); v != "" {
+		return nil
+	}
 	if v := os.Getenv("DUO_API_HOSTNAME"); v == "" {
 		return errors.New("DUO_API_HOSTNAME must be set for acceptance tests")
 	}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+// adminPendingActivation is the status Duo reports for administrators that
+// have been created but have not completed activation yet.
+const adminPendingActivation = "Pending Activation"
+
+func ResourceAdmin() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Duo Administrator resource.",
+
+		CreateContext: ResourceAdminCreate,
+		ReadContext:   ResourceAdminRead,
+		UpdateContext: ResourceAdminUpdate,
+		DeleteContext: ResourceAdminDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Description: "The email address of the administrator.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the administrator.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"phone": {
+				Description: "The phone number of the administrator, in E.164 format.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"role": {
+				Description: "The administrator's role. Must be one of: `Owner` `Administrator` `Application Manager` `User Manager` `Security Analyst` `Help Desk` `Billing` `Phishing Manager` `Read-only`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ValidateFunc: func(val any, key string) (warns []string, errs []error) {
+					roles := []string{"Owner", "Administrator", "Application Manager", "User Manager", "Security Analyst", "Help Desk", "Billing", "Phishing Manager", "Read-only"}
+					for _, r := range roles {
+						if val == r {
+							return
+						}
+					}
+					errs = append(errs, fmt.Errorf("%q must be one of: '%s', got: %s", key, strings.Join(roles, "', '"), val))
+					return
+				},
+			},
+			"restricted_by_admin_units": {
+				Description: "Whether the administrator is restricted by an administrative unit assignment.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"status": {
+				Description: "The administrator's status. Must be one of: `Active` `Disabled`. " +
+					"Administrators that have not completed activation are reported as `Pending Activation`, which is treated as `Active`.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Active",
+				ValidateFunc: func(val any, key string) (warns []string, errs []error) {
+					if val != "Active" && val != "Disabled" {
+						errs = append(errs, fmt.Errorf("%q must be one of: 'Active', 'Disabled', got: %s", key, val))
+					}
+					return
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == adminPendingActivation && new == "Active"
+				},
+			},
+			"send_email": {
+				Description: "Send an activation email to the administrator. Only used when the administrator is created; later changes are ignored.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
+			"pending_activation": {
+				Description: "Whether the administrator has not completed activation yet.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func ResourceAdminCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	values := url.Values{}
+	values.Set("email", d.Get("email").(string))
+	values.Set("name", d.Get("name").(string))
+
+	if v, ok := d.GetOk("phone"); ok {
+		values.Set("phone", v.(string))
+	}
+
+	values.Set("role", d.Get("role").(string))
+
+	if v, ok := d.GetOk("restricted_by_admin_units"); ok {
+		values.Set("restricted_by_admin_units", boolValue(v.(bool)))
+	}
+
+	if v, ok := d.GetOk("send_email"); ok {
+		values.Set("send_email", boolValue(v.(bool)))
+	}
+
+	duo_admin, err := duoClient.CreateAdmin(ctx, values)
+	if err != nil {
+		return diag.Errorf("Unable to create admin: %s", err)
+	}
+
+	d.SetId(duo_admin.AdminID)
+	tflog.Trace(ctx, "Successfully created admin")
+
+	// New administrators start out active (or pending activation); only
+	// disabling needs a follow-up call.
+	if d.Get("status").(string) == "Disabled" {
+		return ResourceAdminUpdate(ctx, d, meta)
+	}
+
+	return ResourceAdminRead(ctx, d, meta)
+}
+
+func ResourceAdminRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	admin_id := d.Id()
+
+	duo_admin, err := duoClient.GetAdmin(ctx, admin_id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Unable to read admin: %s, error: %s", admin_id, err)
+	}
+
+	d.Set("email", duo_admin.Email)
+	d.Set("name", duo_admin.Name)
+	d.Set("phone", duo_admin.Phone)
+	d.Set("role", duo_admin.Role)
+	d.Set("restricted_by_admin_units", duo_admin.RestrictedByAdminUnits)
+	d.Set("status", duo_admin.Status)
+	d.Set("pending_activation", duo_admin.Status == adminPendingActivation)
+
+	return nil
+}
+
+func ResourceAdminUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	admin_id := d.Id()
+	values := url.Values{}
+
+	d.Partial(true)
+
+	if d.HasChange("name") {
+		values.Set("name", d.Get("name").(string))
+	}
+
+	if d.HasChange("phone") {
+		values.Set("phone", d.Get("phone").(string))
+	}
+
+	if d.HasChange("role") {
+		values.Set("role", d.Get("role").(string))
+	}
+
+	if d.HasChange("restricted_by_admin_units") {
+		values.Set("restricted_by_admin_units", boolValue(d.Get("restricted_by_admin_units").(bool)))
+	}
+
+	if d.HasChange("status") {
+		values.Set("status", d.Get("status").(string))
+	}
+
+	if _, err := duoClient.UpdateAdmin(ctx, admin_id, values); err != nil {
+		return diag.Errorf("Unable to update admin: %s, error: %s", admin_id, err)
+	}
+	d.Partial(false)
+
+	return ResourceAdminRead(ctx, d, meta)
+}
+
+func ResourceAdminDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	admin_id := d.Id()
+	if err := duoClient.DeleteAdmin(ctx, admin_id); err != nil {
+		return diag.Errorf("Unable to delete admin: %s, error: %s", admin_id, err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceAdmin(t *testing.T) {
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAdmin,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("duo_admin.test", "email", "admin@test.com"),
+					resource.TestCheckResourceAttr("duo_admin.test", "role", "Help Desk"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceAdminRoleRequired(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		"email": "test@example.com",
+		"name":  "Test",
+	})
+
+	if diags := ResourceAdmin().Validate(config); !diags.HasError() {
+		t.Error("expected an error without a role")
+	}
+}
+
+func TestResourceAdminSendEmailAfterCreate(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "DEXXXXXXXXXXXXXXXXXX",
+		Attributes: map[string]string{
+			"id":                        "DEXXXXXXXXXXXXXXXXXX",
+			"email":                     "test@example.com",
+			"name":                      "Test",
+			"role":                      "Help Desk",
+			"restricted_by_admin_units": "false",
+			"status":                    "Active",
+			"send_email":                "false",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		"email":      "test@example.com",
+		"name":       "Test",
+		"role":       "Help Desk",
+		"send_email": true,
+	})
+
+	diff, err := ResourceAdmin().Diff(context.Background(), state, config, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected no changes, got %v", diff.Attributes)
+	}
+}
+
+const testAccResourceAdmin = `
+resource "duo_admin" "test" {
+	email = "admin@test.com"
+	name  = "test"
+	role  = "Help Desk"
+}
+`
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+// administrativeUnitMembers maps the membership attributes to the path
+// segment used to add or remove a member.
+var administrativeUnitMembers = map[string]string{
+	"admins":       "admin",
+	"groups":       "group",
+	"integrations": "integration",
+}
+
+func ResourceAdministrativeUnit() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Duo Administrative Unit resource.",
+
+		CreateContext: ResourceAdministrativeUnitCreate,
+		ReadContext:   ResourceAdministrativeUnitRead,
+		UpdateContext: ResourceAdministrativeUnitUpdate,
+		DeleteContext: ResourceAdministrativeUnitDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the administrative unit.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The description of the administrative unit.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"restrict_by_groups": {
+				Description: "Whether the administrative unit restricts its administrators to the assigned groups.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"restrict_by_integrations": {
+				Description: "Whether the administrative unit restricts its administrators to the assigned integrations.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"admins": {
+				Description: "The IDs of the administrators assigned to the administrative unit.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"groups": {
+				Description: "The IDs of the groups assigned to the administrative unit.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"integrations": {
+				Description: "The integration keys of the integrations assigned to the administrative unit.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func ResourceAdministrativeUnitCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	values := url.Values{}
+	values.Set("name", d.Get("name").(string))
+	values.Set("description", d.Get("description").(string))
+	values.Set("restrict_by_groups", boolValue(d.Get("restrict_by_groups").(bool)))
+	values.Set("restrict_by_integrations", boolValue(d.Get("restrict_by_integrations").(bool)))
+
+	unit, err := duoClient.CreateAdministrativeUnit(ctx, values)
+	if err != nil {
+		return diag.Errorf("Unable to create administrative unit: %s", err)
+	}
+
+	d.SetId(unit.AdminUnitID)
+	tflog.Trace(ctx, "Successfully created administrative unit")
+
+	if diags := updateAdministrativeUnitMembers(ctx, d, duoClient); diags.HasError() {
+		return diags
+	}
+
+	return ResourceAdministrativeUnitRead(ctx, d, meta)
+}
+
+func ResourceAdministrativeUnitRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	admin_unit_id := d.Id()
+
+	unit, err := duoClient.GetAdministrativeUnit(ctx, admin_unit_id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Unable to read administrative unit: %s, error: %s", admin_unit_id, err)
+	}
+
+	d.Set("name", unit.Name)
+	d.Set("description", unit.Description)
+	d.Set("restrict_by_groups", unit.RestrictByGroups)
+	d.Set("restrict_by_integrations", unit.RestrictByIntegrations)
+	d.Set("admins", unit.Admins)
+	d.Set("groups", unit.Groups)
+	d.Set("integrations", unit.Integrations)
+
+	return nil
+}
+
+func ResourceAdministrativeUnitUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	admin_unit_id := d.Id()
+
+	d.Partial(true)
+
+	if d.HasChanges("name", "description", "restrict_by_groups", "restrict_by_integrations") {
+		values := url.Values{}
+
+		if d.HasChange("name") {
+			values.Set("name", d.Get("name").(string))
+		}
+
+		if d.HasChange("description") {
+			values.Set("description", d.Get("description").(string))
+		}
+
+		if d.HasChange("restrict_by_groups") {
+			values.Set("restrict_by_groups", boolValue(d.Get("restrict_by_groups").(bool)))
+		}
+
+		if d.HasChange("restrict_by_integrations") {
+			values.Set("restrict_by_integrations", boolValue(d.Get("restrict_by_integrations").(bool)))
+		}
+
+		if _, err := duoClient.UpdateAdministrativeUnit(ctx, admin_unit_id, values); err != nil {
+			return diag.Errorf("Unable to update administrative unit: %s, error: %s", admin_unit_id, err)
+		}
+	}
+
+	if diags := updateAdministrativeUnitMembers(ctx, d, duoClient); diags.HasError() {
+		return diags
+	}
+	d.Partial(false)
+
+	return ResourceAdministrativeUnitRead(ctx, d, meta)
+}
+
+func ResourceAdministrativeUnitDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	admin_unit_id := d.Id()
+	if err := duoClient.DeleteAdministrativeUnit(ctx, admin_unit_id); err != nil {
+		return diag.Errorf("Unable to delete administrative unit: %s, error: %s", admin_unit_id, err)
+	}
+	return nil
+}
+
+// updateAdministrativeUnitMembers adds and removes administrators, groups and
+// integrations so that the administrative unit matches the configuration.
+func updateAdministrativeUnitMembers(ctx context.Context, d *schema.ResourceData, duoClient *client.Client) diag.Diagnostics {
+	admin_unit_id := d.Id()
+
+	for attr, segment := range administrativeUnitMembers {
+		if !d.HasChange(attr) {
+			continue
+		}
+
+		o, n := d.GetChange(attr)
+		old_members, new_members := o.(*schema.Set), n.(*schema.Set)
+
+		for _, member := range expandStringSet(old_members.Difference(new_members)) {
+			if err := duoClient.RemoveAdministrativeUnitMember(ctx, admin_unit_id, segment, member); err != nil {
+				return diag.Errorf("Unable to remove %s %s from administrative unit: %s, error: %s", segment, member, admin_unit_id, err)
+			}
+		}
+
+		for _, member := range expandStringSet(new_members.Difference(old_members)) {
+			if err := duoClient.AddAdministrativeUnitMember(ctx, admin_unit_id, segment, member); err != nil {
+				return diag.Errorf("Unable to add %s %s to administrative unit: %s, error: %s", segment, member, admin_unit_id, err)
+			}
+		}
+	}
+
+	tflog.Trace(ctx, "Successfully updated administrative unit members")
+
+	return nil
+}
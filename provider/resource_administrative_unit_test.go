@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceAdministrativeUnit(t *testing.T) {
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAdministrativeUnit,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("duo_administrative_unit.test", "name", "test"),
+					resource.TestCheckResourceAttr("duo_administrative_unit.test", "groups.#", "1"),
+					resource.TestCheckResourceAttr("duo_administrative_unit.test", "admins.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourceAdministrativeUnit = `
+resource "duo_group" "test" {
+	name = "test"
+}
+
+resource "duo_admin" "test" {
+	email = "admin@test.com"
+	name  = "test"
+	role  = "Help Desk"
+}
+
+resource "duo_administrative_unit" "test" {
+	name               = "test"
+	description        = "test"
+	restrict_by_groups = true
+
+	admins = [duo_admin.test.id]
+	groups = [duo_group.test.id]
+}
+`
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+func ResourceBypassCodes() *schema.Resource {
+	return &schema.Resource{
+		Description: "Generates Duo bypass codes for a user. Generating codes clears any existing bypass codes of the user. " +
+			"Once any of the codes has been used or has expired, the resource is removed from state so that new codes are generated.",
+
+		CreateContext: ResourceBypassCodesCreate,
+		ReadContext:   ResourceBypassCodesRead,
+		DeleteContext: ResourceBypassCodesDelete,
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "The ID of the user to generate bypass codes for.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"code_count": {
+				Description: "Number of new bypass codes to create. At most 10 codes can be created at a time.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     10,
+				ForceNew:    true,
+				ValidateFunc: func(val any, key string) (warns []string, errs []error) {
+					if v := val.(int); v < 1 || v > 10 {
+						errs = append(errs, fmt.Errorf("%q must be between 1 and 10, got: %d", key, v))
+					}
+					return
+				},
+			},
+			"reuse_count": {
+				Description: "Number of times each bypass code can be used. If `0`, the codes can be used an unlimited number of times.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				ForceNew:    true,
+			},
+			"valid_secs": {
+				Description: "The number of seconds the codes are valid for. If `0`, the codes never expire.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				ForceNew:    true,
+			},
+			"codes": {
+				Description: "The generated bypass codes.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"bypass_code_ids": {
+				Description: "The IDs of the generated bypass codes.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func ResourceBypassCodesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	user_id := d.Get("user_id").(string)
+
+	values := url.Values{}
+	values.Set("count", strconv.Itoa(d.Get("code_count").(int)))
+	values.Set("reuse_count", strconv.Itoa(d.Get("reuse_count").(int)))
+	values.Set("valid_secs", strconv.Itoa(d.Get("valid_secs").(int)))
+
+	codes, err := duoClient.CreateUserBypassCodes(ctx, user_id, values)
+	if err != nil {
+		return diag.Errorf("Unable to create bypass codes: %s, error: %s", user_id, err)
+	}
+
+	// Creating bypass codes replaces all existing ones, so every code now
+	// listed for the user is one of ours.
+	list, err := duoClient.GetUserBypassCodes(ctx, user_id)
+	if err != nil {
+		return diag.Errorf("Unable to read bypass codes: %s, error: %s", user_id, err)
+	}
+
+	bypass_code_ids := make([]string, 0, len(list))
+	for _, code := range list {
+		bypass_code_ids = append(bypass_code_ids, code.BypassCodeID)
+	}
+
+	d.SetId(user_id)
+	d.Set("codes", codes)
+	d.Set("bypass_code_ids", bypass_code_ids)
+	tflog.Trace(ctx, "Successfully created bypass codes")
+
+	return ResourceBypassCodesRead(ctx, d, meta)
+}
+
+func ResourceBypassCodesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	list, err := duoClient.GetBypassCodes(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to read bypass codes: %s", err)
+	}
+
+	codes := map[string]client.BypassCode{}
+	for _, code := range list {
+		codes[code.BypassCodeID] = code
+	}
+
+	reuse_count := d.Get("reuse_count").(int)
+	now := time.Now().Unix()
+
+	for _, id := range d.Get("bypass_code_ids").([]any) {
+		code, ok := codes[id.(string)]
+
+		var reason string
+		switch {
+		case !ok:
+			reason = "used or deleted"
+		case code.Expiration != nil && *code.Expiration <= now:
+			reason = "expired"
+		case reuse_count > 0 && code.ReuseCount < reuse_count:
+			reason = "used"
+		}
+
+		if reason != "" {
+			tflog.Warn(ctx, "Bypass code is no longer valid, removing from state", map[string]any{
+				"bypass_code_id": id,
+				"reason":         reason,
+			})
+			d.SetId("")
+			return nil
+		}
+	}
+
+	d.Set("user_id", d.Id())
+
+	return nil
+}
+
+func ResourceBypassCodesDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	for _, id := range d.Get("bypass_code_ids").([]any) {
+		bypass_code_id := id.(string)
+
+		if err := duoClient.DeleteBypassCode(ctx, bypass_code_id); err != nil {
+			if client.IsNotFound(err) {
+				continue
+			}
+			return diag.Errorf("Unable to delete bypass code: %s, error: %s", bypass_code_id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceBypassCodes(t *testing.T) {
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceBypassCodes,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("duo_bypass_codes.test", "codes.#", "2"),
+					resource.TestCheckResourceAttr("duo_bypass_codes.test", "bypass_code_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourceBypassCodes = `
+resource "duo_user" "test" {
+	username = "test@test.com"
+}
+
+resource "duo_bypass_codes" "test" {
+	user_id    = duo_user.test.id
+	code_count = 2
+	valid_secs = 3600
+}
+`
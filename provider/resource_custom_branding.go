@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+// customBrandingId is the ID of the duo_custom_branding resource; the custom
+// branding is a singleton.
+const customBrandingId = "branding"
+
+// brandingImages lists the image parameters. Each one is configured through
+// `<param>_path` or `<param>_base64`. `<param>_hash` tracks the declared
+// image and `<param>_remote_hash` the image Duo returned after it was
+// uploaded, since Duo may re-encode images.
+var brandingImages = []string{"logo", "background_img"}
+
+var hexColorRegexp = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+func validateHexColor(val any, key string) (warns []string, errs []error) {
+	if !hexColorRegexp.MatchString(val.(string)) {
+		errs = append(errs, fmt.Errorf("%q must be a hexadecimal color such as '#1a2b3c', got: %s", key, val))
+	}
+	return
+}
+
+func ResourceCustomBranding() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the Duo custom branding. Changes are made to the draft branding and promoted to the live branding when `publish` is set. " +
+			"Images are only uploaded when their content changes. Destroying this resource leaves the branding untouched.",
+
+		CreateContext: ResourceCustomBrandingCreate,
+		ReadContext:   ResourceCustomBrandingRead,
+		UpdateContext: ResourceCustomBrandingUpdate,
+		DeleteContext: ResourceCustomBrandingDelete,
+		CustomizeDiff: ResourceCustomBrandingCustomizeDiff,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"logo_path": {
+				Description:   "Path to a PNG file of the logo, at most 500 x 500 pixels and 200 KB.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"logo_base64"},
+			},
+			"logo_base64": {
+				Description:   "The base64-encoded PNG logo, at most 500 x 500 pixels and 200 KB.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"logo_path"},
+			},
+			"logo_hash": {
+				Description: "SHA-256 of the declared logo, as of the last apply.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"logo_remote_hash": {
+				Description: "SHA-256 of the logo returned by Duo after the last apply, used to detect changes made outside Terraform.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"background_img_path": {
+				Description:   "Path to a PNG file of the background image, at most 3840 x 2160 pixels and 3 MB.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"background_img_base64"},
+			},
+			"background_img_base64": {
+				Description:   "The base64-encoded PNG background image, at most 3840 x 2160 pixels and 3 MB.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"background_img_path"},
+			},
+			"background_img_hash": {
+				Description: "SHA-256 of the declared background image, as of the last apply.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"background_img_remote_hash": {
+				Description: "SHA-256 of the background image returned by Duo after the last apply, used to detect changes made outside Terraform.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"card_accent_color": {
+				Description:  "A hexadecimal color code for the bar at the top of the prompt card.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateHexColor,
+			},
+			"page_background_color": {
+				Description:  "A hexadecimal color code for the page background.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateHexColor,
+			},
+			"powered_by_duo": {
+				Description: "Whether the \"Secured by Duo\" branding is shown.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"sso_custom_username_label": {
+				Description: "Custom label shown to users for the username field of Duo SSO.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"publish": {
+				Description: "Publish the draft branding as the live branding after every change.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+// brandingImage returns the base64-encoded image configured through either
+// `<param>_path` or `<param>_base64`, or an empty string.
+func brandingImage(get func(string) (any, bool), param string) (string, error) {
+	if v, ok := get(param + "_path"); ok {
+		data, err := os.ReadFile(v.(string))
+		if err != nil {
+			return "", fmt.Errorf("unable to read %s: %s", param+"_path", err)
+		}
+		return base64.StdEncoding.EncodeToString(data), nil
+	}
+	if v, ok := get(param + "_base64"); ok {
+		return v.(string), nil
+	}
+	return "", nil
+}
+
+// brandingImageHash returns the SHA-256 of the bytes of a base64-encoded image.
+func brandingImageHash(image string) (string, error) {
+	if image == "" {
+		return "", nil
+	}
+	data, err := base64.StdEncoding.DecodeString(image)
+	if err != nil {
+		return "", fmt.Errorf("unable to decode image: %s", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// getBranding returns the branding read and written by the resource.
+func getBranding(ctx context.Context, duoClient *client.Client, publish bool) (*client.Branding, error) {
+	if publish {
+		return duoClient.GetBranding(ctx)
+	}
+	return duoClient.GetDraftBranding(ctx)
+}
+
+func ResourceCustomBrandingCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	for _, param := range brandingImages {
+		image, err := brandingImage(d.GetOk, param)
+		if err != nil {
+			return err
+		}
+		hash, err := brandingImageHash(image)
+		if err != nil {
+			return err
+		}
+		// Only images that are declared are managed.
+		if hash != "" && hash != d.Get(param+"_hash").(string) {
+			if err := d.SetNew(param+"_hash", hash); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func ResourceCustomBrandingCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	d.SetId(customBrandingId)
+	tflog.Trace(ctx, "Successfully adopted custom branding")
+
+	return ResourceCustomBrandingUpdate(ctx, d, meta)
+}
+
+func ResourceCustomBrandingRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	branding, err := getBranding(ctx, duoClient, d.Get("publish").(bool))
+	if err != nil {
+		return diag.Errorf("Unable to read custom branding: %s", err)
+	}
+
+	remote_images := map[string]string{
+		"logo":           branding.Logo,
+		"background_img": branding.BackgroundImg,
+	}
+	for _, param := range brandingImages {
+		remote_hash, err := brandingImageHash(remote_images[param])
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// Duo may not return the uploaded bytes as is, so the declared image
+		// is only compared with its own hash. A different image than the one
+		// returned after the last apply means it was changed outside
+		// Terraform, and forgetting the declared hash uploads it again.
+		if previous := d.Get(param + "_remote_hash").(string); previous != "" && previous != remote_hash {
+			d.Set(param+"_hash", "")
+		}
+		d.Set(param+"_remote_hash", remote_hash)
+	}
+
+	d.Set("card_accent_color", branding.CardAccentColor)
+	d.Set("page_background_color", branding.PageBackgroundColor)
+	d.Set("powered_by_duo", branding.PoweredByDuo)
+	d.Set("sso_custom_username_label", branding.SSOCustomUsernameLabel)
+
+	return nil
+}
+
+func ResourceCustomBrandingUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	values := url.Values{}
+
+	d.Partial(true)
+
+	for _, param := range brandingImages {
+		if d.HasChange(param + "_hash") {
+			image, err := brandingImage(d.GetOk, param)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if image != "" {
+				values.Set(param, image)
+				// The image returned by Duo from now on is the new reference.
+				d.Set(param+"_remote_hash", "")
+			}
+		}
+	}
+
+	if d.HasChange("card_accent_color") {
+		values.Set("card_accent_color", d.Get("card_accent_color").(string))
+	}
+
+	if d.HasChange("page_background_color") {
+		values.Set("page_background_color", d.Get("page_background_color").(string))
+	}
+
+	if d.HasChange("powered_by_duo") {
+		values.Set("powered_by_duo", fmt.Sprintf("%t", d.Get("powered_by_duo").(bool)))
+	}
+
+	if d.HasChange("sso_custom_username_label") {
+		values.Set("sso_custom_username_label", d.Get("sso_custom_username_label").(string))
+	}
+
+	if len(values) > 0 {
+		if _, err := duoClient.UpdateDraftBranding(ctx, values); err != nil {
+			return diag.Errorf("Unable to update custom branding: %s", err)
+		}
+	}
+
+	if d.Get("publish").(bool) && (len(values) > 0 || d.HasChange("publish")) {
+		if err := duoClient.PublishDraftBranding(ctx); err != nil {
+			return diag.Errorf("Unable to publish custom branding: %s", err)
+		}
+		tflog.Trace(ctx, "Successfully published custom branding")
+	}
+	d.Partial(false)
+
+	return ResourceCustomBrandingRead(ctx, d, meta)
+}
+
+func ResourceCustomBrandingDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	// The custom branding always exists; destroying only removes it from state.
+	d.SetId("")
+	return nil
+}
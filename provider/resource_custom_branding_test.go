@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccResourceCustomBranding(t *testing.T) {
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCustomBranding,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("duo_custom_branding.test", "card_accent_color", "#1a2b3c"),
+					resource.TestCheckResourceAttr("duo_custom_branding.test", "logo_hash", "6b7fa434f92a8b80aab02d9bf1a12e49ffcae424e4013a1c4f68b67e3d2bbcd0"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceCustomBrandingReadImageDrift(t *testing.T) {
+	remote_logo := "cmVlbmNvZGVk"
+	duoClient := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"stat": "OK", "response": {"logo": %q}}`, remote_logo)
+	})
+
+	d := schema.TestResourceDataRaw(t, ResourceCustomBranding().Schema, map[string]any{})
+	d.SetId(customBrandingId)
+	d.Set("logo_hash", "declared")
+
+	// The first read after an upload records the image returned by Duo,
+	// even though it differs from the declared one.
+	if diags := ResourceCustomBrandingRead(context.Background(), d, duoClient); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if hash := d.Get("logo_hash").(string); hash != "declared" {
+		t.Errorf("expected the declared hash to be kept, got %q", hash)
+	}
+	remote_hash := d.Get("logo_remote_hash").(string)
+	if remote_hash == "" {
+		t.Fatal("expected the remote hash to be recorded")
+	}
+
+	// Reading the same image again is not drift.
+	if diags := ResourceCustomBrandingRead(context.Background(), d, duoClient); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if hash := d.Get("logo_hash").(string); hash != "declared" {
+		t.Errorf("expected the declared hash to be kept, got %q", hash)
+	}
+
+	// A different image is drift, and forgets the declared hash.
+	remote_logo = "Y2hhbmdlZA=="
+	if diags := ResourceCustomBrandingRead(context.Background(), d, duoClient); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+	if hash := d.Get("logo_hash").(string); hash != "" {
+		t.Errorf("expected the declared hash to be forgotten, got %q", hash)
+	}
+	if hash := d.Get("logo_remote_hash").(string); hash == remote_hash {
+		t.Error("expected the remote hash to be updated")
+	}
+}
+
+const testAccResourceCustomBranding = `
+resource "duo_custom_branding" "test" {
+	logo_base64       = "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNk+M9QDwADhgGAWjR9awAAAABJRU5ErkJggg=="
+	card_accent_color = "#1a2b3c"
+	publish           = false
+}
+`
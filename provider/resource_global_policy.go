@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+func ResourceGlobalPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the Duo Global Policy. The global policy always exists: creating this resource adopts it, " +
+			"and destroying it only removes it from the Terraform state, leaving its settings as they are, unless `reset_on_destroy` is set.",
+
+		CreateContext: ResourceGlobalPolicyCreate,
+		ReadContext:   ResourceGlobalPolicyRead,
+		UpdateContext: ResourceGlobalPolicyUpdate,
+		DeleteContext: ResourceGlobalPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: ResourcePolicyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sections": policySectionsSchema(),
+			"reset_on_destroy": {
+				Description: "Reset the declared sections to the Duo defaults when this resource is destroyed.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"policy_name": {
+				Description: "The name of the global policy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func ResourceGlobalPolicyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	duo_policy, err := duoClient.GetGlobalPolicy(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to read global policy: %s", err)
+	}
+
+	d.SetId(duo_policy.PolicyKey)
+	tflog.Trace(ctx, "Successfully adopted global policy")
+
+	if _, ok := d.GetOk("sections"); ok {
+		return ResourceGlobalPolicyUpdate(ctx, d, meta)
+	}
+
+	return ResourceGlobalPolicyRead(ctx, d, meta)
+}
+
+func ResourceGlobalPolicyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	duo_policy, err := duoClient.GetPolicy(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("Unable to read global policy: %s, error: %s", d.Id(), err)
+	}
+
+	sections, err := flattenPolicySections(duo_policy.Sections, d.Get("sections").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("policy_name", duo_policy.PolicyName)
+	d.Set("sections", sections)
+
+	return nil
+}
+
+func ResourceGlobalPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	policy_key := d.Id()
+
+	// Only the declared sections are sent; sections that are no longer
+	// declared simply stop being managed, as the global policy cannot lose
+	// any of its sections.
+	sections, err := expandPolicySections(d.Get("sections").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(sections) == 0 {
+		return ResourceGlobalPolicyRead(ctx, d, meta)
+	}
+
+	params := map[string]any{
+		"sections": sections,
+	}
+
+	if _, err := duoClient.UpdatePolicy(ctx, policy_key, params); err != nil {
+		return diag.Errorf("Unable to update global policy: %s, error: %s", policy_key, err)
+	}
+
+	return ResourceGlobalPolicyRead(ctx, d, meta)
+}
+
+// ResourceGlobalPolicyDelete resets the declared sections when
+// `reset_on_destroy` is set, and otherwise only removes the global policy from
+// the state, since it cannot be deleted.
+func ResourceGlobalPolicyDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if !d.Get("reset_on_destroy").(bool) {
+		tflog.Trace(ctx, "Leaving global policy untouched")
+		return nil
+	}
+
+	duoClient := meta.(*client.Client)
+
+	policy_key := d.Id()
+
+	sections, err := expandPolicySections(d.Get("sections").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(sections) == 0 {
+		return nil
+	}
+
+	// Deleting a section of the global policy restores its default settings.
+	sections_to_delete := make([]string, 0, len(sections))
+	for name := range sections {
+		sections_to_delete = append(sections_to_delete, name)
+	}
+
+	params := map[string]any{
+		"sections_to_delete": sections_to_delete,
+	}
+
+	if _, err := duoClient.UpdatePolicy(ctx, policy_key, params); err != nil {
+		return diag.Errorf("Unable to reset global policy: %s, error: %s", policy_key, err)
+	}
+	tflog.Trace(ctx, "Successfully reset global policy")
+
+	return nil
+}
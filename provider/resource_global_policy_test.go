@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceGlobalPolicy(t *testing.T) {
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		CheckDestroy:      testAccCheckGlobalPolicyUntouched,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGlobalPolicy,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("duo_global_policy.test", "policy_name"),
+					resource.TestCheckResourceAttrSet("duo_global_policy.test", "sections"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGlobalPolicyDelete(t *testing.T) {
+	requests := 0
+	duoClient := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method != http.MethodPut || r.URL.Path != "/admin/v2/policies/POXXXXXXXXXXXXXXXXXX" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		var body struct {
+			SectionsToDelete []string `json:"sections_to_delete"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if len(body.SectionsToDelete) != 1 || body.SectionsToDelete[0] != "new_user_policy" {
+			t.Errorf("expected the declared section to be reset, got %v", body.SectionsToDelete)
+		}
+		fmt.Fprint(w, `{"stat": "OK", "response": {}}`)
+	})
+
+	for _, reset_on_destroy := range []bool{false, true} {
+		requests = 0
+
+		d := schema.TestResourceDataRaw(t, ResourceGlobalPolicy().Schema, map[string]any{
+			"sections":         `{"new_user_policy": {"new_user_behavior": "enroll"}}`,
+			"reset_on_destroy": reset_on_destroy,
+		})
+		d.SetId("POXXXXXXXXXXXXXXXXXX")
+
+		if diags := ResourceGlobalPolicyDelete(context.Background(), d, duoClient); diags.HasError() {
+			t.Fatalf("err: %v", diags)
+		}
+
+		expected := 0
+		if reset_on_destroy {
+			expected = 1
+		}
+		if requests != expected {
+			t.Errorf("reset_on_destroy = %t: expected %d requests, got %d", reset_on_destroy, expected, requests)
+		}
+	}
+}
+
+const testAccResourceGlobalPolicy = `
+resource "duo_global_policy" "test" {
+	sections = jsonencode({
+		new_user_policy = {
+			new_user_behavior = "enroll"
+		}
+	})
+}
+`
+
+// testAccCheckGlobalPolicyUntouched checks that destroying the resource left
+// the global policy and its settings in place.
+func testAccCheckGlobalPolicyUntouched(s *terraform.State) error {
+	duoClient, err := testAccClient()
+	if err != nil {
+		return err
+	}
+
+	duo_policy, err := duoClient.GetGlobalPolicy(context.Background())
+	if err != nil {
+		return fmt.Errorf("unable to read global policy: %s", err)
+	}
+
+	section, _ := duo_policy.Sections["new_user_policy"].(map[string]any)
+	if section["new_user_behavior"] != "enroll" {
+		return fmt.Errorf("expected the global policy to be left untouched, got new_user_policy: %v", duo_policy.Sections["new_user_policy"])
+	}
+	return nil
+}
@@ -2,15 +2,14 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/url"
+	"time"
 
-	duoapi "github.com/duosecurity/duo_api_golang"
-	"github.com/duosecurity/duo_api_golang/admin"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
 )
 
 func ResourceGroup() *schema.Resource {
@@ -23,6 +22,13 @@ func ResourceGroup() *schema.Resource {
 		DeleteContext: ResourceGroupDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Description: "The name of the group.",
@@ -51,8 +57,7 @@ func ResourceGroup() *schema.Resource {
 }
 
 func ResourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	duoClient := meta.(*duoapi.DuoApi)
-	duoAdminClient := admin.New(*duoClient)
+	duoClient := meta.(*client.Client)
 
 	values := url.Values{}
 	values.Set("name", d.Get("name").(string))
@@ -65,20 +70,11 @@ func ResourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		values.Set("status", v.(string))
 	}
 
-	_, body, err := duoAdminClient.SignedCall("POST", "/admin/v1/groups", values, duoapi.UseTimeout)
+	group, err := duoClient.CreateGroup(ctx, values)
 	if err != nil {
-		return diag.Errorf("An error has occurred: %s", err)
-	}
-	result := &admin.GetGroupResult{}
-	err = json.Unmarshal(body, result)
-	if err != nil {
-		return diag.Errorf("An error has occurred: %s", err)
-	}
-	if result.Stat != "OK" {
-		return diag.Errorf("Unable to create group: %s, error: %s", result.Stat, *result.Message)
+		return diag.Errorf("Unable to create group: %s", err)
 	}
 
-	group := result.Response
 	d.SetId(group.GroupID)
 	tflog.Trace(ctx, "Successfully created group")
 
@@ -86,25 +82,19 @@ func ResourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta any)
 }
 
 func ResourceGroupRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	duoClient := meta.(*duoapi.DuoApi)
-	duoAdminClient := admin.New(*duoClient)
+	duoClient := meta.(*client.Client)
 
 	group_id := d.Id()
 
-	result, err := duoAdminClient.GetGroup(group_id)
+	group, err := duoClient.GetGroup(ctx, group_id)
 	if err != nil {
-		return diag.Errorf("An error has occurred: %s", err)
-	}
-
-	if result.Stat != "OK" {
-		if *result.Message == "Resource not found" {
+		if client.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Unable to read group: %s, error: %s", result.Stat, *result.Message)
+		return diag.Errorf("Unable to read group: %s, error: %s", group_id, err)
 	}
 
-	group := result.Response
 	d.Set("name", group.Name)
 	d.Set("desc", group.Desc)
 	d.Set("status", group.Status)
@@ -113,8 +103,7 @@ func ResourceGroupRead(ctx context.Context, d *schema.ResourceData, meta any) di
 }
 
 func ResourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	duoClient := meta.(*duoapi.DuoApi)
-	duoAdminClient := admin.New(*duoClient)
+	duoClient := meta.(*client.Client)
 
 	group_id := d.Id()
 	values := url.Values{}
@@ -133,18 +122,8 @@ func ResourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 		values.Set("status", d.Get("status").(string))
 	}
 
-	_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/groups/%s", group_id), values, duoapi.UseTimeout)
-	if err != nil {
-		return diag.Errorf("An error has occurred: %s", err)
-	}
-
-	result := &admin.GetGroupResult{}
-	err = json.Unmarshal(body, result)
-	if err != nil {
-		return diag.Errorf("An error has occurred: %s", err)
-	}
-	if result.Stat != "OK" {
-		return diag.Errorf("Unable to update group: %s, error: %s", group_id, *result.Message)
+	if _, err := duoClient.UpdateGroup(ctx, group_id, values); err != nil {
+		return diag.Errorf("Unable to update group: %s, error: %s", group_id, err)
 	}
 	d.Partial(false)
 
@@ -152,22 +131,11 @@ func ResourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 }
 
 func ResourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	duoClient := meta.(*duoapi.DuoApi)
-	duoAdminClient := admin.New(*duoClient)
+	duoClient := meta.(*client.Client)
 
 	group_id := d.Id()
-	_, body, err := duoAdminClient.SignedCall("DELETE", fmt.Sprintf("/admin/v1/groups/%s", group_id), nil, duoapi.UseTimeout)
-	if err != nil {
-		return diag.Errorf("An error has occurred: %s", err)
-	}
-
-	result := &admin.StringResult{}
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		return diag.Errorf("An error has occurred: %s", err)
-	}
-	if result.Stat != "OK" {
-		return diag.Errorf("Unable to delete group: %s, error: %s", group_id, *result.Message)
+	if err := duoClient.DeleteGroup(ctx, group_id); err != nil {
+		return diag.Errorf("Unable to delete group: %s, error: %s", group_id, err)
 	}
 	return nil
 }
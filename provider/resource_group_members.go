@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+func ResourceGroupMembers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages the exact set of members of a Duo Group. Members added outside of Terraform are removed. " +
+			"Do not use together with `duo_user_group_association` for the same group.",
+
+		CreateContext: ResourceGroupMembersCreate,
+		ReadContext:   ResourceGroupMembersRead,
+		UpdateContext: ResourceGroupMembersUpdate,
+		DeleteContext: ResourceGroupMembersDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Description: "The ID of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"user_ids": {
+				Description: "The IDs of the users that are members of the group.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// listGroupMembers retrieves the IDs of every member of a group.
+func listGroupMembers(ctx context.Context, duoClient *client.Client, group_id string) ([]string, error) {
+	users, err := duoClient.GetGroupUsers(ctx, group_id)
+	if err != nil {
+		return nil, err
+	}
+
+	user_ids := make([]string, 0, len(users))
+	for _, user := range users {
+		user_ids = append(user_ids, user.UserID)
+	}
+
+	return user_ids, nil
+}
+
+// updateGroupMembers adds and removes users so that the members of the group
+// change from old_members to new_members.
+func updateGroupMembers(ctx context.Context, duoClient *client.Client, group_id string, old_members, new_members *schema.Set) diag.Diagnostics {
+	for _, user_id := range expandStringSet(old_members.Difference(new_members)) {
+		if err := duoClient.RemoveUserGroup(ctx, user_id, group_id); err != nil {
+			return diag.Errorf("Unable to remove user %s from group: %s, error: %s", user_id, group_id, err)
+		}
+		tflog.Trace(ctx, "Successfully removed user from group")
+	}
+
+	for _, user_id := range expandStringSet(new_members.Difference(old_members)) {
+		if err := duoClient.AddUserGroup(ctx, user_id, group_id); err != nil {
+			return diag.Errorf("Unable to add user %s to group: %s, error: %s", user_id, group_id, err)
+		}
+		tflog.Trace(ctx, "Successfully added user to group")
+	}
+
+	return nil
+}
+
+func ResourceGroupMembersCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	group_id := d.Get("group_id").(string)
+
+	current, err := listGroupMembers(ctx, duoClient, group_id)
+	if err != nil {
+		return diag.Errorf("Unable to manage group members: %s, error: %s", group_id, err)
+	}
+
+	old_members := schema.NewSet(schema.HashString, nil)
+	for _, user_id := range current {
+		old_members.Add(user_id)
+	}
+	new_members := d.Get("user_ids").(*schema.Set)
+
+	if diags := updateGroupMembers(ctx, duoClient, group_id, old_members, new_members); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(group_id)
+
+	return ResourceGroupMembersRead(ctx, d, meta)
+}
+
+func ResourceGroupMembersRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	group_id := d.Id()
+
+	user_ids, err := listGroupMembers(ctx, duoClient, group_id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Unable to read group members: %s, error: %s", group_id, err)
+	}
+
+	d.Set("group_id", group_id)
+	d.Set("user_ids", user_ids)
+
+	return nil
+}
+
+func ResourceGroupMembersUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	o, n := d.GetChange("user_ids")
+
+	if diags := updateGroupMembers(ctx, duoClient, d.Id(), o.(*schema.Set), n.(*schema.Set)); diags.HasError() {
+		return diags
+	}
+
+	return ResourceGroupMembersRead(ctx, d, meta)
+}
+
+func ResourceGroupMembersDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	old_members := d.Get("user_ids").(*schema.Set)
+	new_members := schema.NewSet(schema.HashString, nil)
+
+	return updateGroupMembers(ctx, duoClient, d.Id(), old_members, new_members)
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceGroupMembers(t *testing.T) {
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGroupMembers,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("duo_group_members.test", "user_ids.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "duo_group_members.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccResourceGroupMembers = `
+resource "duo_user" "a" {
+	username = "test-a@test.com"
+}
+
+resource "duo_user" "b" {
+	username = "test-b@test.com"
+}
+
+resource "duo_group" "test" {
+	name = "test"
+}
+
+resource "duo_group_members" "test" {
+	group_id = duo_group.test.id
+	user_ids = [duo_user.a.id, duo_user.b.id]
+}
+`
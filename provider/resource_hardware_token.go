@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+func ResourceHardwareToken() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Duo OTP Hardware Token resource.",
+
+		CreateContext: ResourceHardwareTokenCreate,
+		ReadContext:   ResourceHardwareTokenRead,
+		DeleteContext: ResourceHardwareTokenDelete,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: ResourceHardwareTokenCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Description: "The type of hardware token. Must be one of: `h6` `h8` `t6` `t8` `d1` `yk`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: func(val any, key string) (warns []string, errs []error) {
+					if val != "h6" && val != "h8" && val != "t6" && val != "t8" && val != "d1" && val != "yk" {
+						errs = append(errs, fmt.Errorf("%q must be one of: 'h6', 'h8', 't6', 't8', 'd1', 'yk', got: %s", key, val))
+					}
+					return
+				},
+			},
+			"serial": {
+				Description: "The serial number of the hardware token.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"secret": {
+				Description:      "The OTP secret, in hex. Required for `h6`, `h8`, `t6` and `t8` tokens.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressSecretDiffAfterImport,
+			},
+			"counter": {
+				Description:      "Initial value for the HOTP counter. Only used for `h6` and `h8` tokens.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressSecretDiffAfterImport,
+			},
+			"private_id": {
+				Description:      "The 6-byte private ID, in hex. Required for `yk` tokens.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressSecretDiffAfterImport,
+			},
+			"aes_key": {
+				Description:      "The 16-byte AES key, in hex. Required for `yk` tokens.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressSecretDiffAfterImport,
+			},
+		},
+	}
+}
+
+// suppressSecretDiffAfterImport ignores the secret inputs of an existing
+// token whose state has none, as after an import. Duo never returns them, so
+// declaring them would otherwise replace the imported token.
+func suppressSecretDiffAfterImport(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && old == ""
+}
+
+// ResourceHardwareTokenCustomizeDiff checks that the inputs required by the
+// type of token are set, and that no other ones are.
+func ResourceHardwareTokenCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+	token_type := d.Get("type").(string)
+
+	required := map[string][]string{
+		"h6": {"secret"},
+		"h8": {"secret"},
+		"t6": {"secret"},
+		"t8": {"secret"},
+		"yk": {"private_id", "aes_key"},
+	}
+	allowed := map[string]map[string]bool{
+		"h6": {"secret": true, "counter": true},
+		"h8": {"secret": true, "counter": true},
+		"t6": {"secret": true},
+		"t8": {"secret": true},
+		"yk": {"private_id": true, "aes_key": true},
+	}
+
+	for _, key := range required[token_type] {
+		if _, ok := d.GetOk(key); !ok && d.NewValueKnown(key) {
+			return fmt.Errorf("%q is required for %s tokens", key, token_type)
+		}
+	}
+
+	for _, key := range []string{"secret", "counter", "private_id", "aes_key"} {
+		if _, ok := d.GetOk(key); !ok || allowed[token_type][key] {
+			continue
+		}
+		return fmt.Errorf("%q is not supported for %s tokens", key, token_type)
+	}
+
+	return nil
+}
+
+func ResourceHardwareTokenCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	token_type := d.Get("type").(string)
+
+	values := url.Values{}
+	values.Set("type", token_type)
+	values.Set("serial", d.Get("serial").(string))
+
+	// ResourceHardwareTokenCustomizeDiff validated the inputs of the type.
+	if v, ok := d.GetOk("secret"); ok {
+		values.Set("secret", v.(string))
+	}
+
+	if v, ok := d.GetOk("counter"); ok {
+		values.Set("counter", strconv.Itoa(v.(int)))
+	}
+
+	if v, ok := d.GetOk("private_id"); ok {
+		values.Set("private_id", v.(string))
+	}
+
+	if v, ok := d.GetOk("aes_key"); ok {
+		values.Set("aes_key", v.(string))
+	}
+
+	token, err := duoClient.CreateToken(ctx, values)
+	if err != nil {
+		return diag.Errorf("Unable to create hardware token: %s", err)
+	}
+
+	d.SetId(token.TokenID)
+	tflog.Trace(ctx, "Successfully created hardware token")
+
+	return ResourceHardwareTokenRead(ctx, d, meta)
+}
+
+func ResourceHardwareTokenRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	token_id := d.Id()
+
+	token, err := duoClient.GetToken(ctx, token_id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Unable to read hardware token: %s, error: %s", token_id, err)
+	}
+
+	d.Set("type", token.Type)
+	d.Set("serial", token.Serial)
+
+	return nil
+}
+
+func ResourceHardwareTokenDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	token_id := d.Id()
+	if err := duoClient.DeleteToken(ctx, token_id); err != nil {
+		return diag.Errorf("Unable to delete hardware token: %s, error: %s", token_id, err)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+func ResourceHardwareTokenResync() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resynchronizes the counter of a Duo OTP Hardware Token using three consecutive codes. " +
+			"Change `triggers` (or the codes) to resynchronize again.",
+
+		CreateContext: ResourceHardwareTokenResyncCreate,
+		ReadContext:   ResourceHardwareTokenResyncRead,
+		DeleteContext: ResourceHardwareTokenResyncDelete,
+
+		Schema: map[string]*schema.Schema{
+			"token_id": {
+				Description: "The ID of the hardware token to resynchronize.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"code1": {
+				Description: "The first code generated by the token.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+			},
+			"code2": {
+				Description: "The second code generated by the token.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+			},
+			"code3": {
+				Description: "The third code generated by the token.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, resynchronizes the token again.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func ResourceHardwareTokenResyncCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	token_id := d.Get("token_id").(string)
+
+	values := url.Values{}
+	values.Set("code1", d.Get("code1").(string))
+	values.Set("code2", d.Get("code2").(string))
+	values.Set("code3", d.Get("code3").(string))
+
+	if err := duoClient.ResyncToken(ctx, token_id, values); err != nil {
+		return diag.Errorf("Unable to resync hardware token: %s, error: %s", token_id, err)
+	}
+
+	d.SetId(token_id)
+	tflog.Trace(ctx, "Successfully resynced hardware token")
+
+	return ResourceHardwareTokenResyncRead(ctx, d, meta)
+}
+
+func ResourceHardwareTokenResyncRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	token_id := d.Id()
+
+	token, err := duoClient.GetToken(ctx, token_id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Unable to read hardware token: %s, error: %s", token_id, err)
+	}
+
+	d.Set("token_id", token.TokenID)
+
+	return nil
+}
+
+func ResourceHardwareTokenResyncDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	// A resync cannot be undone; destroying only removes it from state.
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceHardwareToken(t *testing.T) {
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceHardwareToken,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("duo_hardware_token.test", "type", "h6"),
+					resource.TestCheckResourceAttr("duo_hardware_token.test", "serial", "123456"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceHardwareTokenCustomizeDiff(t *testing.T) {
+	for _, tc := range []struct {
+		config map[string]any
+		valid  bool
+	}{
+		{map[string]any{"type": "h6", "serial": "1", "secret": "31", "counter": 1}, true},
+		{map[string]any{"type": "h8", "serial": "1"}, false},
+		{map[string]any{"type": "t6", "serial": "1", "secret": "31"}, true},
+		{map[string]any{"type": "t8", "serial": "1", "counter": 1, "secret": "31"}, false},
+		{map[string]any{"type": "yk", "serial": "1", "private_id": "31", "aes_key": "31"}, true},
+		{map[string]any{"type": "yk", "serial": "1", "private_id": "31"}, false},
+		{map[string]any{"type": "d1", "serial": "1"}, true},
+		{map[string]any{"type": "d1", "serial": "1", "secret": "31"}, false},
+	} {
+		_, err := ResourceHardwareToken().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.config), nil)
+		if tc.valid && err != nil {
+			t.Errorf("%v: err: %s", tc.config, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("%v: expected an error", tc.config)
+		}
+	}
+}
+
+func TestResourceHardwareTokenImportedSecret(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "DHXXXXXXXXXXXXXXXXXX",
+		Attributes: map[string]string{
+			"id":     "DHXXXXXXXXXXXXXXXXXX",
+			"type":   "h6",
+			"serial": "123456",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		"type":   "h6",
+		"serial": "123456",
+		"secret": "3132333435363738393031323334353637383930",
+	})
+
+	diff, err := ResourceHardwareToken().Diff(context.Background(), state, config, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if diff.RequiresNew() {
+		t.Error("expected an imported token not to be replaced")
+	}
+}
+
+const testAccResourceHardwareToken = `
+resource "duo_hardware_token" "test" {
+	type   = "h6"
+	serial = "123456"
+	secret = "3132333435363738393031323334353637383930"
+}
+`
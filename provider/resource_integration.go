@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+// integrationPermissions maps the boolean adminapi_* attributes to their
+// client.Integration fields.
+var integrationPermissions = map[string]func(*client.Integration) client.Flag{
+	"adminapi_admins":         func(i *client.Integration) client.Flag { return i.AdminAPIAdmins },
+	"adminapi_info":           func(i *client.Integration) client.Flag { return i.AdminAPIInfo },
+	"adminapi_integrations":   func(i *client.Integration) client.Flag { return i.AdminAPIIntegrations },
+	"adminapi_read_log":       func(i *client.Integration) client.Flag { return i.AdminAPIReadLog },
+	"adminapi_read_resource":  func(i *client.Integration) client.Flag { return i.AdminAPIReadResource },
+	"adminapi_settings":       func(i *client.Integration) client.Flag { return i.AdminAPISettings },
+	"adminapi_write_resource": func(i *client.Integration) client.Flag { return i.AdminAPIWriteResource },
+}
+
+func ResourceIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Duo Integration resource.",
+
+		CreateContext: ResourceIntegrationCreate,
+		ReadContext:   ResourceIntegrationRead,
+		UpdateContext: ResourceIntegrationUpdate,
+		DeleteContext: ResourceIntegrationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the integration.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"type": {
+				Description: "The type of the integration, e.g. `adminapi`, `authapi`, `websdk`, `rdp`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"notes": {
+				Description: "Description of the integration.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"greeting": {
+				Description: "Voice greeting read before the authentication instructions to users who authenticate with a phone callback.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"groups_allowed": {
+				Description: "The IDs of the groups allowed to authenticate with the integration. If empty, all groups are allowed.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"adminapi_admins": {
+				Description: "Grant an Admin API integration permission to read and write administrators and administrative units.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"adminapi_info": {
+				Description: "Grant an Admin API integration permission to read account info.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"adminapi_integrations": {
+				Description: "Grant an Admin API integration permission to read and write integrations.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"adminapi_read_log": {
+				Description: "Grant an Admin API integration permission to read logs.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"adminapi_read_resource": {
+				Description: "Grant an Admin API integration permission to read resources such as users, phones and hardware tokens.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"adminapi_settings": {
+				Description: "Grant an Admin API integration permission to read and write settings.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"adminapi_write_resource": {
+				Description: "Grant an Admin API integration permission to write resources such as users, phones and hardware tokens.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"networks_for_api_access": {
+				Description: "A comma-separated list of IP addresses, ranges or CIDRs allowed to use an Admin API or Accounts API integration. If empty, all networks are allowed.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"self_service_allowed": {
+				Description: "Whether users may use self-service from the Duo Prompt to add, delete, or update their authentication devices.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"policy_key": {
+				Description: "The key of the policy applied to the integration.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"integration_key": {
+				Description: "The integration key.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"secret_key": {
+				Description: "The secret key of the integration.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// boolValue converts a boolean attribute to the form expected by Duo.
+func boolValue(v bool) string {
+	if v {
+		return "1"
+	}
+	return "0"
+}
+
+func ResourceIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	values := url.Values{}
+	values.Set("name", d.Get("name").(string))
+	values.Set("type", d.Get("type").(string))
+
+	if v, ok := d.GetOk("notes"); ok {
+		values.Set("notes", v.(string))
+	}
+
+	if v, ok := d.GetOk("greeting"); ok {
+		values.Set("greeting", v.(string))
+	}
+
+	if v, ok := d.GetOk("groups_allowed"); ok {
+		values.Set("groups_allowed", strings.Join(expandStringSet(v.(*schema.Set)), ","))
+	}
+
+	for k := range integrationPermissions {
+		if v, ok := d.GetOk(k); ok {
+			values.Set(k, boolValue(v.(bool)))
+		}
+	}
+
+	if v, ok := d.GetOk("networks_for_api_access"); ok {
+		values.Set("networks_for_api_access", v.(string))
+	}
+
+	if v, ok := d.GetOk("self_service_allowed"); ok {
+		values.Set("self_service_allowed", boolValue(v.(bool)))
+	}
+
+	if v, ok := d.GetOk("policy_key"); ok {
+		values.Set("policy_key", v.(string))
+	}
+
+	integration, err := duoClient.CreateIntegration(ctx, values)
+	if err != nil {
+		return diag.Errorf("Unable to create integration: %s", err)
+	}
+
+	d.SetId(integration.IntegrationKey)
+	tflog.Trace(ctx, "Successfully created integration")
+
+	return ResourceIntegrationRead(ctx, d, meta)
+}
+
+func ResourceIntegrationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	integration_key := d.Id()
+
+	integration, err := duoClient.GetIntegration(ctx, integration_key)
+	if err != nil {
+		if client.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Unable to read integration: %s, error: %s", integration_key, err)
+	}
+
+	d.Set("name", integration.Name)
+	d.Set("type", integration.Type)
+	d.Set("notes", integration.Notes)
+	d.Set("greeting", integration.Greeting)
+	d.Set("groups_allowed", integration.GroupsAllowed)
+	for k, f := range integrationPermissions {
+		d.Set(k, bool(f(integration)))
+	}
+	d.Set("networks_for_api_access", integration.NetworksForAPIAccess)
+	d.Set("self_service_allowed", bool(integration.SelfServiceAllowed))
+	d.Set("policy_key", integration.PolicyKey)
+	d.Set("integration_key", integration.IntegrationKey)
+	d.Set("secret_key", integration.SecretKey)
+
+	return nil
+}
+
+func ResourceIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	integration_key := d.Id()
+	values := url.Values{}
+
+	d.Partial(true)
+
+	if d.HasChange("name") {
+		values.Set("name", d.Get("name").(string))
+	}
+
+	if d.HasChange("notes") {
+		values.Set("notes", d.Get("notes").(string))
+	}
+
+	if d.HasChange("greeting") {
+		values.Set("greeting", d.Get("greeting").(string))
+	}
+
+	if d.HasChange("groups_allowed") {
+		values.Set("groups_allowed", strings.Join(expandStringSet(d.Get("groups_allowed").(*schema.Set)), ","))
+	}
+
+	for k := range integrationPermissions {
+		if d.HasChange(k) {
+			values.Set(k, boolValue(d.Get(k).(bool)))
+		}
+	}
+
+	if d.HasChange("networks_for_api_access") {
+		values.Set("networks_for_api_access", d.Get("networks_for_api_access").(string))
+	}
+
+	if d.HasChange("self_service_allowed") {
+		values.Set("self_service_allowed", boolValue(d.Get("self_service_allowed").(bool)))
+	}
+
+	if d.HasChange("policy_key") {
+		values.Set("policy_key", d.Get("policy_key").(string))
+	}
+
+	if _, err := duoClient.UpdateIntegration(ctx, integration_key, values); err != nil {
+		return diag.Errorf("Unable to update integration: %s, error: %s", integration_key, err)
+	}
+	d.Partial(false)
+
+	return ResourceIntegrationRead(ctx, d, meta)
+}
+
+func ResourceIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	integration_key := d.Id()
+	if err := duoClient.DeleteIntegration(ctx, integration_key); err != nil {
+		return diag.Errorf("Unable to delete integration: %s, error: %s", integration_key, err)
+	}
+	return nil
+}
+
+// expandStringSet converts a set of strings to a slice.
+func expandStringSet(s *schema.Set) []string {
+	list := make([]string, 0, s.Len())
+	for _, v := range s.List() {
+		list = append(list, v.(string))
+	}
+	return list
+}
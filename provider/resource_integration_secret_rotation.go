@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+func ResourceIntegrationSecretRotation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Resets the secret key of a Duo Integration. Change `triggers` to rotate the secret key again.",
+
+		CreateContext: ResourceIntegrationSecretRotationCreate,
+		ReadContext:   ResourceIntegrationSecretRotationRead,
+		DeleteContext: ResourceIntegrationSecretRotationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"integration_key": {
+				Description: "The integration key of the integration to rotate the secret key of.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, rotates the secret key again.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"secret_key": {
+				Description: "The new secret key of the integration.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func ResourceIntegrationSecretRotationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	integration_key := d.Get("integration_key").(string)
+
+	integration, err := duoClient.ResetIntegrationSecretKey(ctx, integration_key)
+	if err != nil {
+		return diag.Errorf("Unable to rotate integration secret key: %s, error: %s", integration_key, err)
+	}
+
+	d.SetId(integration_key)
+	d.Set("secret_key", integration.SecretKey)
+	tflog.Trace(ctx, "Successfully rotated integration secret key")
+
+	return ResourceIntegrationSecretRotationRead(ctx, d, meta)
+}
+
+func ResourceIntegrationSecretRotationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	integration_key := d.Id()
+
+	integration, err := duoClient.GetIntegration(ctx, integration_key)
+	if err != nil {
+		if client.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Unable to read integration: %s, error: %s", integration_key, err)
+	}
+
+	d.Set("integration_key", integration.IntegrationKey)
+
+	return nil
+}
+
+func ResourceIntegrationSecretRotationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	// A rotated secret key cannot be restored; destroying only removes it from state.
+	d.SetId("")
+	return nil
+}
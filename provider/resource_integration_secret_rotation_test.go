@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceIntegrationSecretRotation(t *testing.T) {
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIntegrationSecretRotation,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("duo_integration_secret_rotation.test", "integration_key", "duo_integration.test", "integration_key"),
+					resource.TestCheckResourceAttrSet("duo_integration_secret_rotation.test", "secret_key"),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourceIntegrationSecretRotation = `
+resource "duo_integration" "test" {
+	name = "test"
+	type = "authapi"
+}
+
+resource "duo_integration_secret_rotation" "test" {
+	integration_key = duo_integration.test.integration_key
+
+	triggers = {
+		rotation = "1"
+	}
+}
+`
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceIntegration(t *testing.T) {
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIntegration,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("duo_integration.test", "name", "test"),
+					resource.TestCheckResourceAttr("duo_integration.test", "adminapi_read_log", "true"),
+					resource.TestCheckResourceAttrSet("duo_integration.test", "integration_key"),
+					resource.TestCheckResourceAttrSet("duo_integration.test", "secret_key"),
+				),
+			},
+			{
+				ResourceName:      "duo_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccResourceIntegration = `
+resource "duo_integration" "test" {
+	name              = "test"
+	type              = "adminapi"
+	adminapi_read_log = true
+}
+`
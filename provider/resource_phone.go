@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+func ResourcePhone() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Duo Phone resource.",
+
+		CreateContext: ResourcePhoneCreate,
+		ReadContext:   ResourcePhoneRead,
+		UpdateContext: ResourcePhoneUpdate,
+		DeleteContext: ResourcePhoneDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"number": {
+				Description: "The phone number in E.164 format, e.g. `+15555550100`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"extension": {
+				Description: "The extension.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"name": {
+				Description: "Free-form label for the phone.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"type": {
+				Description:      "The type of phone. Must be one of: `unknown` `mobile` `landline`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressCaseDiff,
+				ValidateFunc: func(val any, key string) (warns []string, errs []error) {
+					v := strings.ToLower(val.(string))
+					if v != "unknown" && v != "mobile" && v != "landline" {
+						errs = append(errs, fmt.Errorf("%q must be one of: 'unknown', 'mobile', 'landline', got: %s", key, val))
+					}
+					return
+				},
+			},
+			"platform": {
+				Description:      "The phone platform. Must be one of: `unknown` `google android` `apple ios` `windows phone` `rim blackberry` `java j2me` `palm webos` `symbian os` `windows mobile` `generic smartphone`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressCaseDiff,
+				ValidateFunc: func(val any, key string) (warns []string, errs []error) {
+					platforms := []string{"unknown", "google android", "apple ios", "windows phone", "rim blackberry", "java j2me", "palm webos", "symbian os", "windows mobile", "generic smartphone"}
+					for _, p := range platforms {
+						if strings.ToLower(val.(string)) == p {
+							return
+						}
+					}
+					errs = append(errs, fmt.Errorf("%q must be one of: '%s', got: %s", key, strings.Join(platforms, "', '"), val))
+					return
+				},
+			},
+			"predelay": {
+				Description: "The time (in seconds) to wait after the number picks up and before dialing the extension.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"postdelay": {
+				Description: "The time (in seconds) to wait after the extension is dialed and before speaking the prompt.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"activated": {
+				Description: "Whether Duo Mobile has been activated on this phone.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// suppressCaseDiff ignores differences in letter case, since Duo accepts
+// lowercase enum values but returns them capitalized (e.g. `Apple iOS`).
+func suppressCaseDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(old, new)
+}
+
+func ResourcePhoneCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	values := url.Values{}
+
+	if v, ok := d.GetOk("number"); ok {
+		values.Set("number", v.(string))
+	}
+
+	if v, ok := d.GetOk("extension"); ok {
+		values.Set("extension", v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		values.Set("name", v.(string))
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		values.Set("type", v.(string))
+	}
+
+	if v, ok := d.GetOk("platform"); ok {
+		values.Set("platform", v.(string))
+	}
+
+	if v, ok := d.GetOk("predelay"); ok {
+		values.Set("predelay", v.(string))
+	}
+
+	if v, ok := d.GetOk("postdelay"); ok {
+		values.Set("postdelay", v.(string))
+	}
+
+	phone, err := duoClient.CreatePhone(ctx, values)
+	if err != nil {
+		return diag.Errorf("Unable to create phone: %s", err)
+	}
+
+	d.SetId(phone.PhoneID)
+	tflog.Trace(ctx, "Successfully created phone")
+
+	return ResourcePhoneRead(ctx, d, meta)
+}
+
+func ResourcePhoneRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	phone_id := d.Id()
+
+	phone, err := duoClient.GetPhone(ctx, phone_id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Unable to read phone: %s, error: %s", phone_id, err)
+	}
+
+	d.Set("number", phone.Number)
+	d.Set("extension", phone.Extension)
+	d.Set("name", phone.Name)
+	d.Set("type", phone.Type)
+	d.Set("platform", phone.Platform)
+	d.Set("predelay", phone.Predelay)
+	d.Set("postdelay", phone.Postdelay)
+	d.Set("activated", phone.Activated)
+
+	return nil
+}
+
+func ResourcePhoneUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	phone_id := d.Id()
+	values := url.Values{}
+
+	d.Partial(true)
+
+	if d.HasChange("number") {
+		values.Set("number", d.Get("number").(string))
+	}
+
+	if d.HasChange("extension") {
+		values.Set("extension", d.Get("extension").(string))
+	}
+
+	if d.HasChange("name") {
+		values.Set("name", d.Get("name").(string))
+	}
+
+	if d.HasChange("type") {
+		values.Set("type", d.Get("type").(string))
+	}
+
+	if d.HasChange("platform") {
+		values.Set("platform", d.Get("platform").(string))
+	}
+
+	if d.HasChange("predelay") {
+		values.Set("predelay", d.Get("predelay").(string))
+	}
+
+	if d.HasChange("postdelay") {
+		values.Set("postdelay", d.Get("postdelay").(string))
+	}
+
+	if _, err := duoClient.UpdatePhone(ctx, phone_id, values); err != nil {
+		return diag.Errorf("Unable to update phone: %s, error: %s", phone_id, err)
+	}
+	d.Partial(false)
+
+	return ResourcePhoneRead(ctx, d, meta)
+}
+
+func ResourcePhoneDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	phone_id := d.Id()
+	if err := duoClient.DeletePhone(ctx, phone_id); err != nil {
+		return diag.Errorf("Unable to delete phone: %s, error: %s", phone_id, err)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+func ResourcePhoneActivation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Generates a Duo Mobile activation for a phone, optionally sending it by SMS. " +
+			"Change `triggers` to generate a new activation.",
+
+		CreateContext: ResourcePhoneActivationCreate,
+		ReadContext:   ResourcePhoneActivationRead,
+		DeleteContext: ResourcePhoneActivationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"phone_id": {
+				Description: "The ID of the phone to activate.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"send_sms": {
+				Description: "Send the activation link to the phone by SMS instead of only generating it.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"install": {
+				Description: "Also generate (or send) a link to install Duo Mobile.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"valid_secs": {
+				Description: "The number of seconds the activation code is valid for. Duo defaults to 86400 (one day).",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"activation_msg": {
+				Description: "Custom SMS activation message. Only used when `send_sms` is `true`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"installation_msg": {
+				Description: "Custom SMS installation message. Only used when `send_sms` and `install` are `true`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Description: "Arbitrary map of values that, when changed, generates a new activation.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"activation_url": {
+				Description: "The Duo Mobile activation URL. Not returned when `send_sms` is `true`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"activation_barcode": {
+				Description: "URL of an image of the activation QR code.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"installation_url": {
+				Description: "The Duo Mobile installation URL, when `install` is `true`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func ResourcePhoneActivationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	phone_id := d.Get("phone_id").(string)
+	send_sms := d.Get("send_sms").(bool)
+
+	values := url.Values{}
+
+	if v, ok := d.GetOk("valid_secs"); ok {
+		values.Set("valid_secs", strconv.Itoa(v.(int)))
+	}
+
+	if d.Get("install").(bool) {
+		values.Set("install", "1")
+	}
+
+	var activation *client.PhoneActivation
+	var err error
+	if send_sms {
+		if v, ok := d.GetOk("activation_msg"); ok {
+			values.Set("activation_msg", v.(string))
+		}
+
+		if v, ok := d.GetOk("installation_msg"); ok {
+			values.Set("installation_msg", v.(string))
+		}
+
+		activation, err = duoClient.SendPhoneSMSActivation(ctx, phone_id, values)
+	} else {
+		activation, err = duoClient.CreatePhoneActivationURL(ctx, phone_id, values)
+	}
+	if err != nil {
+		return diag.Errorf("Unable to activate phone: %s, error: %s", phone_id, err)
+	}
+
+	d.SetId(phone_id)
+	d.Set("activation_url", activation.ActivationURL)
+	d.Set("activation_barcode", activation.ActivationBarcode)
+	d.Set("installation_url", activation.InstallationURL)
+	d.Set("valid_secs", activation.ValidSecs)
+	tflog.Trace(ctx, "Successfully generated phone activation")
+
+	return ResourcePhoneActivationRead(ctx, d, meta)
+}
+
+func ResourcePhoneActivationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	phone_id := d.Id()
+
+	phone, err := duoClient.GetPhone(ctx, phone_id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Unable to read phone: %s, error: %s", phone_id, err)
+	}
+
+	d.Set("phone_id", phone.PhoneID)
+
+	return nil
+}
+
+func ResourcePhoneActivationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	// An activation cannot be revoked; it simply expires after valid_secs.
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourcePhoneActivation(t *testing.T) {
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePhoneActivation,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("duo_phone_activation.test", "activation_url"),
+					resource.TestCheckResourceAttrSet("duo_phone_activation.test", "activation_barcode"),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourcePhoneActivation = `
+resource "duo_phone" "test" {
+	number   = "+15555550100"
+	type     = "mobile"
+	platform = "google android"
+}
+
+resource "duo_phone_activation" "test" {
+	phone_id = duo_phone.test.id
+
+	triggers = {
+		phone = duo_phone.test.id
+	}
+}
+`
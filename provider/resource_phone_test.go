@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourcePhone(t *testing.T) {
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePhone,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("duo_phone.test", "number", "+15555550100"),
+					resource.TestCheckResourceAttr("duo_phone.test", "name", "test"),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourcePhone = `
+resource "duo_phone" "test" {
+	number = "+15555550100"
+	name   = "test"
+	type   = "mobile"
+}
+`
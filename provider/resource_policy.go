@@ -0,0 +1,374 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+// policySectionsSchema is the schema of the `sections` attribute, shared by
+// every policy resource.
+func policySectionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "JSON document of the policy sections, keyed by section name (e.g. `authentication_methods`, `remembered_devices`). " +
+			"Only the sections and settings declared here are managed; sections that are no longer declared are left as they are, and differences in formatting and key order are ignored.",
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateFunc:     validation.StringIsJSON,
+		DiffSuppressFunc: suppressEquivalentPolicySections,
+	}
+}
+
+// suppressEquivalentPolicySections ignores differences in formatting between
+// two JSON documents that describe the same sections.
+func suppressEquivalentPolicySections(k, old, new string, d *schema.ResourceData) bool {
+	old_sections, err := expandPolicySections(old)
+	if err != nil {
+		return false
+	}
+	new_sections, err := expandPolicySections(new)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(old_sections, new_sections)
+}
+
+// expandPolicySections decodes the `sections` attribute.
+func expandPolicySections(s string) (map[string]any, error) {
+	sections := map[string]any{}
+	if s == "" {
+		return sections, nil
+	}
+	if err := json.Unmarshal([]byte(s), &sections); err != nil {
+		return nil, fmt.Errorf("unable to decode policy sections: %s", err)
+	}
+	return sections, nil
+}
+
+// flattenPolicySections encodes the sections returned by Duo, keeping only
+// the sections and settings present in declared. Duo returns every setting of
+// a section, most of them defaults, so comparing whole sections would report
+// drift on settings that were never configured.
+func flattenPolicySections(remote map[string]any, declared string) (string, error) {
+	declared_sections, err := expandPolicySections(declared)
+	if err != nil {
+		return "", err
+	}
+
+	sections := map[string]any{}
+	for name, declared_section := range declared_sections {
+		remote_section, ok := remote[name]
+		if !ok {
+			continue
+		}
+
+		declared_settings, ok := declared_section.(map[string]any)
+		remote_settings, ok2 := remote_section.(map[string]any)
+		if !ok || !ok2 {
+			sections[name] = remote_section
+			continue
+		}
+
+		settings := map[string]any{}
+		for key := range declared_settings {
+			if v, ok := remote_settings[key]; ok {
+				settings[key] = v
+			}
+		}
+		sections[name] = settings
+	}
+
+	return encodePolicySections(sections)
+}
+
+// encodePolicySections encodes sections for the `sections` attribute.
+func encodePolicySections(sections map[string]any) (string, error) {
+	if len(sections) == 0 {
+		return "", nil
+	}
+
+	b, err := json.Marshal(sections)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func ResourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Duo Policy resource.",
+
+		CreateContext: ResourcePolicyCreate,
+		ReadContext:   ResourcePolicyRead,
+		UpdateContext: ResourcePolicyUpdate,
+		DeleteContext: ResourcePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: ResourcePolicyImport,
+		},
+		CustomizeDiff: ResourcePolicyCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"policy_name": {
+				Description: "The name of the policy.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"sections": policySectionsSchema(),
+			"reset_sections": {
+				Description: "Names of sections to reset to the Duo defaults. A section is reset when it is added to this list, and must not also be declared in `sections`.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"apply_to_apps": {
+				Description: "The integration keys of the applications this policy is applied to.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"apply_to_groups_in_apps": {
+				Description: "Applies this policy to groups of users within an application. " +
+					"Write-only: Duo does not return group policy assignments, so they are neither read back nor imported, and changes made outside Terraform are not detected. " +
+					"After an import, only the assignments declared here are applied; existing assignments are never removed.",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"integration_key": {
+							Description: "The integration key of the application.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"group_ids": {
+							Description: "The IDs of the groups the policy is applied to within the application.",
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourcePolicyCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	sections, err := expandPolicySections(d.Get("sections").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := map[string]any{
+		"policy_name": d.Get("policy_name").(string),
+		"sections":    sections,
+	}
+
+	if v, ok := d.GetOk("apply_to_apps"); ok {
+		params["apply_to_apps"] = map[string]any{
+			"apply_policy": expandStringSet(v.(*schema.Set)),
+		}
+	}
+
+	if v, ok := d.GetOk("apply_to_groups_in_apps"); ok {
+		params["apply_to_groups_in_apps"] = map[string]any{
+			"apply_group_policies_list": expandPolicyGroupsInApps(flattenPolicyGroupsInApps(v.(*schema.Set))),
+		}
+	}
+
+	duo_policy, err := duoClient.CreatePolicy(ctx, params)
+	if err != nil {
+		return diag.Errorf("Unable to create policy: %s", err)
+	}
+
+	d.SetId(duo_policy.PolicyKey)
+	tflog.Trace(ctx, "Successfully created policy")
+
+	return ResourcePolicyRead(ctx, d, meta)
+}
+
+func ResourcePolicyRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	policy_key := d.Id()
+
+	duo_policy, err := duoClient.GetPolicy(ctx, policy_key)
+	if err != nil {
+		if client.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Unable to read policy: %s, error: %s", policy_key, err)
+	}
+
+	sections, err := flattenPolicySections(duo_policy.Sections, d.Get("sections").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Duo does not list the applications of a policy, but every integration
+	// names the policy applied to it.
+	integrations, err := duoClient.GetIntegrations(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to read applications of policy: %s, error: %s", policy_key, err)
+	}
+
+	apps := []string{}
+	for _, integration := range integrations {
+		if integration.PolicyKey == policy_key {
+			apps = append(apps, integration.IntegrationKey)
+		}
+	}
+
+	d.Set("policy_name", duo_policy.PolicyName)
+	d.Set("sections", sections)
+	d.Set("apply_to_apps", apps)
+
+	return nil
+}
+
+func ResourcePolicyUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	policy_key := d.Id()
+	params := map[string]any{}
+
+	d.Partial(true)
+
+	if d.HasChange("policy_name") {
+		params["policy_name"] = d.Get("policy_name").(string)
+	}
+
+	// Sections that are no longer declared simply stop being managed; only
+	// sections listed in `reset_sections` are reset to the Duo defaults.
+	if d.HasChange("sections") {
+		sections, err := expandPolicySections(d.Get("sections").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		params["sections"] = sections
+	}
+
+	if d.HasChange("reset_sections") {
+		o, n := d.GetChange("reset_sections")
+		if sections_to_delete := expandStringSet(n.(*schema.Set).Difference(o.(*schema.Set))); len(sections_to_delete) > 0 {
+			params["sections_to_delete"] = sections_to_delete
+		}
+	}
+
+	if d.HasChange("apply_to_apps") {
+		o, n := d.GetChange("apply_to_apps")
+		old_apps, new_apps := o.(*schema.Set), n.(*schema.Set)
+
+		params["apply_to_apps"] = map[string]any{
+			"apply_policy":   expandStringSet(new_apps.Difference(old_apps)),
+			"unapply_policy": expandStringSet(old_apps.Difference(new_apps)),
+		}
+	}
+
+	if d.HasChange("apply_to_groups_in_apps") {
+		o, n := d.GetChange("apply_to_groups_in_apps")
+		old_groups := flattenPolicyGroupsInApps(o.(*schema.Set))
+		new_groups := flattenPolicyGroupsInApps(n.(*schema.Set))
+
+		params["apply_to_groups_in_apps"] = map[string]any{
+			"apply_group_policies_list":   expandPolicyGroupsInApps(new_groups.Difference(old_groups)),
+			"unapply_group_policies_list": expandPolicyGroupsInApps(old_groups.Difference(new_groups)),
+		}
+	}
+
+	if _, err := duoClient.UpdatePolicy(ctx, policy_key, params); err != nil {
+		return diag.Errorf("Unable to update policy: %s, error: %s", policy_key, err)
+	}
+	d.Partial(false)
+
+	return ResourcePolicyRead(ctx, d, meta)
+}
+
+func ResourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	policy_key := d.Id()
+	if err := duoClient.DeletePolicy(ctx, policy_key); err != nil {
+		return diag.Errorf("Unable to delete policy: %s, error: %s", policy_key, err)
+	}
+	return nil
+}
+
+// ResourcePolicyImport resolves the policy key, e.g. of `global`. Sections are
+// left empty, so that only the ones declared afterwards become managed and
+// the others are left as they are.
+func ResourcePolicyImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	duoClient := meta.(*client.Client)
+
+	policy_key := d.Id()
+
+	duo_policy, err := duoClient.GetPolicy(ctx, policy_key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import policy: %s, error: %s", policy_key, err)
+	}
+	d.SetId(duo_policy.PolicyKey)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// ResourcePolicyCustomizeDiff rejects sections that are both declared and
+// reset.
+func ResourcePolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("sections") || !d.NewValueKnown("reset_sections") {
+		return nil
+	}
+
+	sections, err := expandPolicySections(d.Get("sections").(string))
+	if err != nil {
+		return err
+	}
+	for _, name := range expandStringSet(d.Get("reset_sections").(*schema.Set)) {
+		if _, ok := sections[name]; ok {
+			return fmt.Errorf("section %q cannot be both declared in \"sections\" and listed in \"reset_sections\"", name)
+		}
+	}
+	return nil
+}
+
+// flattenPolicyGroupsInApps converts the `apply_to_groups_in_apps` blocks to a
+// set of `<integration_key>/<group_id>` pairs, so that changes can be computed
+// per group.
+func flattenPolicyGroupsInApps(s *schema.Set) *schema.Set {
+	pairs := schema.NewSet(schema.HashString, nil)
+	for _, v := range s.List() {
+		app := v.(map[string]any)
+		for _, group_id := range expandStringSet(app["group_ids"].(*schema.Set)) {
+			pairs.Add(fmt.Sprintf("%s/%s", app["integration_key"].(string), group_id))
+		}
+	}
+	return pairs
+}
+
+// expandPolicyGroupsInApps groups `<integration_key>/<group_id>` pairs into
+// the list format expected by Duo.
+func expandPolicyGroupsInApps(pairs *schema.Set) []map[string]any {
+	groups := map[string][]string{}
+	for _, pair := range expandStringSet(pairs) {
+		integration_key, group_id, _ := strings.Cut(pair, "/")
+		groups[integration_key] = append(groups[integration_key], group_id)
+	}
+
+	list := make([]map[string]any, 0, len(groups))
+	for integration_key, group_ids := range groups {
+		list = append(list, map[string]any{
+			"app_integration_key": integration_key,
+			"group_id_list":       group_ids,
+		})
+	}
+	return list
+}
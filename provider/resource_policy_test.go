@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+func TestAccResourcePolicy(t *testing.T) {
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePolicy,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("duo_policy.test", "policy_name", "test"),
+					resource.TestCheckResourceAttrSet("duo_policy.test", "sections"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourcePolicyReadApps(t *testing.T) {
+	duoClient := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/admin/v2/policies/POXXXXXXXXXXXXXXXXXX":
+			fmt.Fprint(w, `{"stat": "OK", "response": {"policy_key": "POXXXXXXXXXXXXXXXXXX", "policy_name": "test", "sections": {}}}`)
+		case "/admin/v1/integrations":
+			fmt.Fprint(w, `{"stat": "OK", "response": [{"integration_key": "DIXXXXXXXXXXXXXXXXX1", "policy_key": "POXXXXXXXXXXXXXXXXXX"}, {"integration_key": "DIXXXXXXXXXXXXXXXXX2", "policy_key": "POYYYYYYYYYYYYYYYYYY"}], "metadata": {}}`)
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, ResourcePolicy().Schema, map[string]any{"policy_name": "test"})
+	d.SetId("POXXXXXXXXXXXXXXXXXX")
+
+	if diags := ResourcePolicyRead(context.Background(), d, duoClient); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	apps := expandStringSet(d.Get("apply_to_apps").(*schema.Set))
+	if len(apps) != 1 || apps[0] != "DIXXXXXXXXXXXXXXXXX1" {
+		t.Errorf("expected the application using the policy, got %v", apps)
+	}
+}
+
+// newTestPolicyServer returns a client for a server holding a policy with two
+// sections, and the body of every update it receives.
+func newTestPolicyServer(t *testing.T) (*client.Client, *[]map[string]any) {
+	updates := []map[string]any{}
+	duoClient := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/admin/v1/integrations":
+			fmt.Fprint(w, `{"stat": "OK", "response": [], "metadata": {}}`)
+		case r.Method == http.MethodPut:
+			update := map[string]any{}
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				t.Fatalf("err: %s", err)
+			}
+			updates = append(updates, update)
+			fallthrough
+		default:
+			fmt.Fprint(w, `{"stat": "OK", "response": {"policy_key": "POXXXXXXXXXXXXXXXXXX", "policy_name": "test", "sections": {`+
+				`"new_user_policy": {"new_user_behavior": "deny"}, "remembered_devices": {"browser_apps": {"enabled": true}}}}}`)
+		}
+	})
+	return duoClient, &updates
+}
+
+// testPolicyUpdate plans config against state and applies the update.
+func testPolicyUpdate(t *testing.T, duoClient *client.Client, state map[string]string, config map[string]any) {
+	state["id"] = "POXXXXXXXXXXXXXXXXXX"
+	instance := &terraform.InstanceState{ID: "POXXXXXXXXXXXXXXXXXX", Attributes: state}
+
+	diff, err := ResourcePolicy().Diff(context.Background(), instance, terraform.NewResourceConfigRaw(config), duoClient)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	d, err := schema.InternalMap(ResourcePolicy().Schema).Data(instance, diff)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if diags := ResourcePolicyUpdate(context.Background(), d, duoClient); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+}
+
+func TestResourcePolicyUpdateDroppedSection(t *testing.T) {
+	duoClient, updates := newTestPolicyServer(t)
+
+	testPolicyUpdate(t, duoClient, map[string]string{
+		"policy_name": "test",
+		"sections":    `{"new_user_policy":{"new_user_behavior":"deny"},"remembered_devices":{"browser_apps":{"enabled":true}}}`,
+	}, map[string]any{
+		"policy_name": "test",
+		"sections":    `{"new_user_policy": {"new_user_behavior": "deny"}}`,
+	})
+
+	if len(*updates) != 1 {
+		t.Fatalf("expected 1 update, got %d", len(*updates))
+	}
+	if _, ok := (*updates)[0]["sections_to_delete"]; ok {
+		t.Errorf("expected the dropped section to be left as is, got %v", (*updates)[0])
+	}
+}
+
+func TestResourcePolicyUpdateResetSections(t *testing.T) {
+	duoClient, updates := newTestPolicyServer(t)
+
+	testPolicyUpdate(t, duoClient, map[string]string{
+		"policy_name": "test",
+		"sections":    `{"new_user_policy":{"new_user_behavior":"deny"}}`,
+	}, map[string]any{
+		"policy_name":    "test",
+		"sections":       `{"new_user_policy": {"new_user_behavior": "deny"}}`,
+		"reset_sections": []any{"remembered_devices"},
+	})
+
+	if len(*updates) != 1 {
+		t.Fatalf("expected 1 update, got %d", len(*updates))
+	}
+	if deleted := fmt.Sprint((*updates)[0]["sections_to_delete"]); deleted != "[remembered_devices]" {
+		t.Errorf("expected the listed section to be reset, got %s", deleted)
+	}
+}
+
+func TestResourcePolicyImportThenApply(t *testing.T) {
+	duoClient, updates := newTestPolicyServer(t)
+
+	d := ResourcePolicy().Data(&terraform.InstanceState{ID: "POXXXXXXXXXXXXXXXXXX"})
+	imported, err := ResourcePolicyImport(context.Background(), d, duoClient)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if diags := ResourcePolicyRead(context.Background(), imported[0], duoClient); diags.HasError() {
+		t.Fatalf("err: %v", diags)
+	}
+
+	state := imported[0].State()
+	if sections := state.Attributes["sections"]; sections != "" {
+		t.Errorf("expected no section to be imported, got %s", sections)
+	}
+
+	testPolicyUpdate(t, duoClient, state.Attributes, map[string]any{
+		"policy_name": "test",
+		"sections":    `{"new_user_policy": {"new_user_behavior": "enroll"}}`,
+	})
+
+	if len(*updates) != 1 {
+		t.Fatalf("expected 1 update, got %d", len(*updates))
+	}
+	if _, ok := (*updates)[0]["sections_to_delete"]; ok {
+		t.Errorf("expected no section to be deleted, got %v", (*updates)[0])
+	}
+	if sections := fmt.Sprint((*updates)[0]["sections"]); sections != "map[new_user_policy:map[new_user_behavior:enroll]]" {
+		t.Errorf("expected only the declared section to be sent, got %s", sections)
+	}
+}
+
+func TestResourcePolicyCustomizeDiff(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]any{
+		"policy_name":    "test",
+		"sections":       `{"new_user_policy": {"new_user_behavior": "deny"}}`,
+		"reset_sections": []any{"new_user_policy"},
+	})
+
+	if _, err := ResourcePolicy().Diff(context.Background(), nil, config, nil); err == nil {
+		t.Error("expected an error for a section both declared and reset")
+	}
+}
+
+const testAccResourcePolicy = `
+resource "duo_integration" "test" {
+	name = "test"
+	type = "websdk"
+}
+
+resource "duo_policy" "test" {
+	policy_name = "test"
+
+	sections = jsonencode({
+		new_user_policy = {
+			new_user_behavior = "deny"
+		}
+	})
+
+	apply_to_apps = [duo_integration.test.integration_key]
+}
+`
@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+// settingsId is the ID of the duo_settings resource; the account settings are
+// a singleton.
+const settingsId = "settings"
+
+// settingsAttributes lists the settings managed by duo_settings.
+var settingsAttributes = map[string]*schema.Schema{
+	"caller_id": {
+		Description: "Automated calls will appear to come from this number. This does not apply to text messages.",
+		Type:        schema.TypeString,
+	},
+	"fraud_email": {
+		Description: "The email address to be notified when a user reports a fraudulent authentication attempt or is locked out due to failed authentication attempts.",
+		Type:        schema.TypeString,
+	},
+	"fraud_email_enabled": {
+		Description: "Whether emails are sent to `fraud_email`.",
+		Type:        schema.TypeBool,
+	},
+	"helpdesk_bypass": {
+		Description: "Whether Help Desk administrators may generate bypass codes for users. Must be one of: `allow` `limit` `deny`.",
+		Type:        schema.TypeString,
+		ValidateFunc: func(val any, key string) (warns []string, errs []error) {
+			if val != "allow" && val != "limit" && val != "deny" {
+				errs = append(errs, fmt.Errorf("%q must be one of: 'allow', 'limit', 'deny', got: %s", key, val))
+			}
+			return
+		},
+	},
+	"helpdesk_bypass_expiration": {
+		Description: "The number of minutes after which bypass codes created by Help Desk administrators expire, when `helpdesk_bypass` is `limit`.",
+		Type:        schema.TypeInt,
+	},
+	"helpdesk_can_send_enroll_email": {
+		Description: "Whether Help Desk administrators may send or resend enrollment emails to users.",
+		Type:        schema.TypeBool,
+	},
+	"inactive_user_expiration": {
+		Description: "The number of days after which users that have not logged in are automatically deleted. `0` disables the expiration.",
+		Type:        schema.TypeInt,
+	},
+	"keypress_confirm": {
+		Description: "The key for users to press to authenticate, or empty if any key should be pressed.",
+		Type:        schema.TypeString,
+	},
+	"keypress_fraud": {
+		Description: "The key for users to report fraud, or empty if any key should be pressed.",
+		Type:        schema.TypeString,
+	},
+	"language": {
+		Description: "The language used in the phone authentication call, SMS messages and the Duo Prompt, e.g. `EN`, `DE`, `FR`.",
+		Type:        schema.TypeString,
+	},
+	"lockout_expire_duration": {
+		Description: "The number of minutes after which a user locked out by `lockout_threshold` is automatically unlocked. `0` disables automatic unlocking.",
+		Type:        schema.TypeInt,
+	},
+	"lockout_threshold": {
+		Description: "The number of consecutive failed authentication attempts before a user is locked out.",
+		Type:        schema.TypeInt,
+	},
+	"log_retention_days": {
+		Description: "The number of days logs are kept before they are deleted. `0` keeps logs indefinitely.",
+		Type:        schema.TypeInt,
+	},
+	"minimum_password_length": {
+		Description: "The minimum number of characters of an administrator's password.",
+		Type:        schema.TypeInt,
+	},
+	"name": {
+		Description: "The customer name.",
+		Type:        schema.TypeString,
+	},
+	"password_requires_lower_alpha": {
+		Description: "Whether administrator passwords must contain a lowercase letter.",
+		Type:        schema.TypeBool,
+	},
+	"password_requires_numeric": {
+		Description: "Whether administrator passwords must contain a number.",
+		Type:        schema.TypeBool,
+	},
+	"password_requires_special": {
+		Description: "Whether administrator passwords must contain a special character.",
+		Type:        schema.TypeBool,
+	},
+	"password_requires_upper_alpha": {
+		Description: "Whether administrator passwords must contain an uppercase letter.",
+		Type:        schema.TypeBool,
+	},
+	"sms_batch": {
+		Description: "The number of passcodes sent at one time in an SMS message.",
+		Type:        schema.TypeInt,
+	},
+	"sms_expiration": {
+		Description: "The number of minutes after which SMS passcodes expire. `0` disables expiration.",
+		Type:        schema.TypeInt,
+	},
+	"sms_message": {
+		Description: "The description sent with every batch of SMS passcodes.",
+		Type:        schema.TypeString,
+	},
+	"sms_refresh": {
+		Description: "Whether a new SMS passcode is sent after the previous one is used.",
+		Type:        schema.TypeBool,
+	},
+	"telephony_warning_min": {
+		Description: "The number of telephony credits below which a warning email is sent to administrators. `0` disables the warning.",
+		Type:        schema.TypeInt,
+	},
+	"timezone": {
+		Description: "The time zone used in the Admin Panel and reports, e.g. `US/Eastern`.",
+		Type:        schema.TypeString,
+	},
+}
+
+func ResourceSettings() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"restore_on_destroy": {
+			Description: "Restore the declared settings to the values they had before they were managed by this resource when it is destroyed.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"original_values": {
+			Description: "The values the declared settings had before they were managed by this resource.",
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+
+	// Every setting is optional: only the declared ones are managed, the
+	// others are read from Duo to report their current value.
+	for k, v := range settingsAttributes {
+		attr := *v
+		attr.Optional = true
+		attr.Computed = true
+		s[k] = &attr
+	}
+
+	return &schema.Resource{
+		Description: "Manages the Duo account settings. Only the declared settings are changed; the others are reported as computed. " +
+			"Destroying this resource leaves the settings untouched unless `restore_on_destroy` is set.",
+
+		CreateContext: ResourceSettingsCreate,
+		ReadContext:   ResourceSettingsRead,
+		UpdateContext: ResourceSettingsUpdate,
+		DeleteContext: ResourceSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: ResourceSettingsImport,
+		},
+
+		Schema: s,
+	}
+}
+
+// settingValue converts a setting returned by Duo to a string.
+func settingValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// settingAttributeValue converts an attribute to the form expected by Duo.
+func settingAttributeValue(d *schema.ResourceData, k string) string {
+	switch v := d.Get(k).(type) {
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func ResourceSettingsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	current, err := duoClient.GetSettings(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to read settings: %s", err)
+	}
+
+	values := url.Values{}
+	original_values := map[string]string{}
+
+	config := d.GetRawConfig()
+	for k := range settingsAttributes {
+		if config.GetAttr(k).IsNull() {
+			continue
+		}
+		values.Set(k, settingAttributeValue(d, k))
+		original_values[k] = settingValue(current[k])
+	}
+
+	if len(values) > 0 {
+		if _, err := duoClient.UpdateSettings(ctx, values); err != nil {
+			return diag.Errorf("Unable to update settings: %s", err)
+		}
+	}
+
+	d.SetId(settingsId)
+	d.Set("original_values", original_values)
+	tflog.Trace(ctx, "Successfully adopted settings")
+
+	return ResourceSettingsRead(ctx, d, meta)
+}
+
+func ResourceSettingsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	settings, err := duoClient.GetSettings(ctx)
+	if err != nil {
+		return diag.Errorf("Unable to read settings: %s", err)
+	}
+
+	for k, v := range settingsAttributes {
+		value := settingValue(settings[k])
+
+		switch v.Type {
+		case schema.TypeBool:
+			b, _ := strconv.ParseBool(value)
+			d.Set(k, b)
+		case schema.TypeInt:
+			i, _ := strconv.Atoi(value)
+			d.Set(k, i)
+		default:
+			d.Set(k, value)
+		}
+	}
+
+	return nil
+}
+
+func ResourceSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	values := url.Values{}
+
+	d.Partial(true)
+
+	config := d.GetRawConfig()
+	original_values := map[string]string{}
+	for k, v := range d.Get("original_values").(map[string]any) {
+		original_values[k] = v.(string)
+	}
+
+	for k := range settingsAttributes {
+		if config.GetAttr(k).IsNull() {
+			continue
+		}
+		if d.HasChange(k) {
+			values.Set(k, settingAttributeValue(d, k))
+		}
+		// Remember the value of settings declared after creation as well.
+		if _, ok := original_values[k]; !ok {
+			o, _ := d.GetChange(k)
+			original_values[k] = fmt.Sprintf("%v", o)
+		}
+	}
+
+	if len(values) > 0 {
+		if _, err := duoClient.UpdateSettings(ctx, values); err != nil {
+			return diag.Errorf("Unable to update settings: %s", err)
+		}
+	}
+
+	d.Set("original_values", original_values)
+	d.Partial(false)
+
+	return ResourceSettingsRead(ctx, d, meta)
+}
+
+// ResourceSettingsImport only accepts the ID set by Create, since the account
+// settings are a singleton.
+func ResourceSettingsImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if d.Id() != settingsId {
+		return nil, fmt.Errorf("unexpected ID (%q), the account settings are imported with the ID %q", d.Id(), settingsId)
+	}
+	d.SetId(settingsId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func ResourceSettingsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	if !d.Get("restore_on_destroy").(bool) {
+		tflog.Trace(ctx, "Leaving settings untouched")
+		return nil
+	}
+
+	duoClient := meta.(*client.Client)
+
+	values := url.Values{}
+	for k, v := range d.Get("original_values").(map[string]any) {
+		values.Set(k, v.(string))
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	if _, err := duoClient.UpdateSettings(ctx, values); err != nil {
+		return diag.Errorf("Unable to restore settings: %s", err)
+	}
+	tflog.Trace(ctx, "Successfully restored settings")
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceSettings(t *testing.T) {
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSettings,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("duo_settings.test", "lockout_threshold", "10"),
+					resource.TestCheckResourceAttrSet("duo_settings.test", "timezone"),
+					resource.TestCheckResourceAttrSet("duo_settings.test", "original_values.lockout_threshold"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceSettingsImport(t *testing.T) {
+	d := ResourceSettings().Data(&terraform.InstanceState{ID: settingsId})
+	imported, err := ResourceSettingsImport(context.Background(), d, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if id := imported[0].Id(); id != settingsId {
+		t.Errorf("expected ID %q, got %q", settingsId, id)
+	}
+
+	d = ResourceSettings().Data(&terraform.InstanceState{ID: "anything"})
+	if _, err := ResourceSettingsImport(context.Background(), d, nil); err == nil {
+		t.Error("expected an error for an unexpected ID")
+	}
+}
+
+const testAccResourceSettings = `
+resource "duo_settings" "test" {
+	lockout_threshold  = 10
+	restore_on_destroy = true
+}
+`
@@ -2,15 +2,14 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/url"
+	"time"
 
-	duoapi "github.com/duosecurity/duo_api_golang"
-	"github.com/duosecurity/duo_api_golang/admin"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
 )
 
 func ResourceUser() *schema.Resource {
@@ -23,6 +22,13 @@ func ResourceUser() *schema.Resource {
 		DeleteContext: ResourceUserDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"username": {
 				Description: "The name of the user to create.",
@@ -71,8 +77,7 @@ func ResourceUser() *schema.Resource {
 }
 
 func ResourceUserCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	duoClient := meta.(*duoapi.DuoApi)
-	duoAdminClient := admin.New(*duoClient)
+	duoClient := meta.(*client.Client)
 
 	values := url.Values{}
 	values.Set("username", d.Get("username").(string))
@@ -101,20 +106,11 @@ func ResourceUserCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 		values.Set("lastname", v.(string))
 	}
 
-	_, body, err := duoAdminClient.SignedCall("POST", "/admin/v1/users", values, duoapi.UseTimeout)
+	user, err := duoClient.CreateUser(ctx, values)
 	if err != nil {
-		return diag.Errorf("An error has occurred: %s", err)
-	}
-	result := &admin.GetUserResult{}
-	err = json.Unmarshal(body, result)
-	if err != nil {
-		return diag.Errorf("An error has occurred: %s", err)
-	}
-	if result.Stat != "OK" {
-		return diag.Errorf("Unable to create user: %s, error: %s", result.Stat, *result.Message)
+		return diag.Errorf("Unable to create user: %s", err)
 	}
 
-	user := result.Response
 	d.SetId(user.UserID)
 	tflog.Trace(ctx, "Successfully created user")
 
@@ -122,25 +118,19 @@ func ResourceUserCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 }
 
 func ResourceUserRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	duoClient := meta.(*duoapi.DuoApi)
-	duoAdminClient := admin.New(*duoClient)
+	duoClient := meta.(*client.Client)
 
 	user_id := d.Id()
 
-	result, err := duoAdminClient.GetUser(user_id)
+	user, err := duoClient.GetUser(ctx, user_id)
 	if err != nil {
-		return diag.Errorf("An error has occurred: %s", err)
-	}
-
-	if result.Stat != "OK" {
-		if *result.Message == "Resource not found" {
+		if client.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
-		return diag.Errorf("Unable to read user: %s, error: %s", result.Stat, *result.Message)
+		return diag.Errorf("Unable to read user: %s, error: %s", user_id, err)
 	}
 
-	user := result.Response
 	d.Set("username", user.Username)
 	d.Set("realname", user.RealName)
 	d.Set("email", user.Email)
@@ -153,8 +143,7 @@ func ResourceUserRead(ctx context.Context, d *schema.ResourceData, meta any) dia
 }
 
 func ResourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	duoClient := meta.(*duoapi.DuoApi)
-	duoAdminClient := admin.New(*duoClient)
+	duoClient := meta.(*client.Client)
 
 	user_id := d.Id()
 	values := url.Values{}
@@ -189,18 +178,8 @@ func ResourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 		values.Set("lastname", d.Get("lastname").(string))
 	}
 
-	_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/users/%s", user_id), values, duoapi.UseTimeout)
-	if err != nil {
-		return diag.Errorf("An error has occurred: %s", err)
-	}
-
-	result := &admin.GetUserResult{}
-	err = json.Unmarshal(body, result)
-	if err != nil {
-		return diag.Errorf("An error has occurred: %s", err)
-	}
-	if result.Stat != "OK" {
-		return diag.Errorf("Unable to update user: %s, error: %s", user_id, *result.Message)
+	if _, err := duoClient.UpdateUser(ctx, user_id, values); err != nil {
+		return diag.Errorf("Unable to update user: %s, error: %s", user_id, err)
 	}
 	d.Partial(false)
 
@@ -208,22 +187,11 @@ func ResourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 }
 
 func ResourceUserDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	duoClient := meta.(*duoapi.DuoApi)
-	duoAdminClient := admin.New(*duoClient)
+	duoClient := meta.(*client.Client)
 
 	user_id := d.Id()
-	_, body, err := duoAdminClient.SignedCall("DELETE", fmt.Sprintf("/admin/v1/users/%s", user_id), nil, duoapi.UseTimeout)
-	if err != nil {
-		return diag.Errorf("An error has occurred: %s", err)
-	}
-
-	result := &admin.StringResult{}
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		return diag.Errorf("An error has occurred: %s", err)
-	}
-	if result.Stat != "OK" {
-		return diag.Errorf("Unable to delete user: %s, error: %s", user_id, *result.Message)
+	if err := duoClient.DeleteUser(ctx, user_id); err != nil {
+		return diag.Errorf("Unable to delete user: %s, error: %s", user_id, err)
 	}
 	return nil
 }
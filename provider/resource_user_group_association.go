@@ -2,16 +2,12 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/url"
-	"strings"
+	"time"
 
-	duoapi "github.com/duosecurity/duo_api_golang"
-	"github.com/duosecurity/duo_api_golang/admin"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
 )
 
 func ResourceUserGroupAssociation() *schema.Resource {
@@ -21,7 +17,18 @@ func ResourceUserGroupAssociation() *schema.Resource {
 		CreateContext: ResourceUserGroupAssociationCreate,
 		ReadContext:   ResourceUserGroupAssociationRead,
 		DeleteContext: ResourceUserGroupAssociationDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer:      importCompositeId("group_id", "user_id"),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			compositeIdStateUpgraderV0("group_id", "user_id"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -41,42 +48,59 @@ func ResourceUserGroupAssociation() *schema.Resource {
 }
 
 func ResourceUserGroupAssociationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	duoClient := meta.(*duoapi.DuoApi)
-	duoAdminClient := admin.New(*duoClient)
+	duoClient := meta.(*client.Client)
 
 	group_id := d.Get("group_id").(string)
 	user_id := d.Get("user_id").(string)
 
-	values := url.Values{}
-	values.Set("group_id", group_id)
-
-	_, body, err := duoAdminClient.SignedCall("POST", fmt.Sprintf("/admin/v1/users/%s/groups", user_id), values, duoapi.UseTimeout)
-	if err != nil {
-		return diag.Errorf("An error has occurred: %s", err)
+	if err := duoClient.AddUserGroup(ctx, user_id, group_id); err != nil {
+		return diag.Errorf("Unable to add user to group: %s, error: %s", group_id, err)
 	}
 
-	result := &admin.StringResult{}
-	err = json.Unmarshal(body, &result)
-
-	if err != nil {
-		return diag.Errorf("An error has occurred: %s", err)
-	}
-	if result.Stat != "OK" {
-		return diag.Errorf("Unable to add user to group: %s, error: %s", result.Stat, *result.Message)
-	}
-
-	id := strings.Join([]string{group_id, user_id}, "-")
-	d.SetId(id)
+	d.SetId(buildCompositeId(group_id, user_id))
 	tflog.Trace(ctx, "Successfully added user to group")
 
 	return ResourceUserGroupAssociationRead(ctx, d, meta)
 }
 
 func ResourceUserGroupAssociationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	id := d.Id()
+	duoClient := meta.(*client.Client)
 
-	s := strings.Split(id, "-")
-	group_id, user_id := s[0], s[1]
+	parts, err := parseCompositeId(d.Id(), "group_id", "user_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	group_id, user_id := parts[0], parts[1]
+
+	groups, err := duoClient.GetUserGroups(ctx, user_id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "User no longer exists, removing association from state", map[string]any{
+				"user_id": user_id,
+			})
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Unable to read user groups: %s, error: %s", user_id, err)
+	}
+
+	// A deleted group is no longer listed among the user's groups either.
+	found := false
+	for _, group := range groups {
+		if group.GroupID == group_id {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		tflog.Warn(ctx, "User is no longer a member of group, removing association from state", map[string]any{
+			"group_id": group_id,
+			"user_id":  user_id,
+		})
+		d.SetId("")
+		return nil
+	}
 
 	d.Set("group_id", group_id)
 	d.Set("user_id", user_id)
@@ -85,27 +109,16 @@ func ResourceUserGroupAssociationRead(ctx context.Context, d *schema.ResourceDat
 }
 
 func ResourceUserGroupAssociationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	duoClient := meta.(*duoapi.DuoApi)
-	duoAdminClient := admin.New(*duoClient)
-
-	id := d.Id()
-
-	s := strings.Split(id, "-")
-	group_id, user_id := s[0], s[1]
+	duoClient := meta.(*client.Client)
 
-	_, body, err := duoAdminClient.SignedCall("DELETE", fmt.Sprintf("/admin/v1/users/%s/groups/%s", user_id, group_id), nil, duoapi.UseTimeout)
+	parts, err := parseCompositeId(d.Id(), "group_id", "user_id")
 	if err != nil {
-		return diag.Errorf("An error has occurred: %s", err)
+		return diag.FromErr(err)
 	}
+	group_id, user_id := parts[0], parts[1]
 
-	result := &admin.StringResult{}
-	err = json.Unmarshal(body, &result)
-
-	if err != nil {
-		return diag.Errorf("An error has occurred: %s", err)
-	}
-	if result.Stat != "OK" {
-		return diag.Errorf("Unable to remove user from group: %s, error: %s", group_id, *result.Message)
+	if err := duoClient.RemoveUserGroup(ctx, user_id, group_id); err != nil {
+		return diag.Errorf("Unable to remove user from group: %s, error: %s", group_id, err)
 	}
 
 	return nil
@@ -19,6 +19,11 @@ func TestAccResourceUserGroupAssociation(t *testing.T) {
 					resource.TestCheckResourceAttr("duo_user.test", "username", "test@test.com"),
 				),
 			},
+			{
+				ResourceName:      "duo_user_group_association.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
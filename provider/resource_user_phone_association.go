@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+func ResourceUserPhoneAssociation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Duo User Phone association resource.",
+
+		CreateContext: ResourceUserPhoneAssociationCreate,
+		ReadContext:   ResourceUserPhoneAssociationRead,
+		DeleteContext: ResourceUserPhoneAssociationDelete,
+		Importer:      importCompositeId("phone_id", "user_id"),
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			compositeIdStateUpgraderV0("phone_id", "user_id"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"phone_id": {
+				Description: "The ID of the phone to associate with the user.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"user_id": {
+				Description: "The ID of the user to associate with the phone.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func ResourceUserPhoneAssociationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	phone_id := d.Get("phone_id").(string)
+	user_id := d.Get("user_id").(string)
+
+	if err := duoClient.AddUserPhone(ctx, user_id, phone_id); err != nil {
+		return diag.Errorf("Unable to add phone to user: %s, error: %s", user_id, err)
+	}
+
+	d.SetId(buildCompositeId(phone_id, user_id))
+	tflog.Trace(ctx, "Successfully added phone to user")
+
+	return ResourceUserPhoneAssociationRead(ctx, d, meta)
+}
+
+func ResourceUserPhoneAssociationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	parts, err := parseCompositeId(d.Id(), "phone_id", "user_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	phone_id, user_id := parts[0], parts[1]
+
+	phones, err := duoClient.GetUserPhones(ctx, user_id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Unable to read user phones: %s, error: %s", user_id, err)
+	}
+
+	found := false
+	for _, phone := range phones {
+		if phone.PhoneID == phone_id {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		tflog.Warn(ctx, "Phone is no longer associated with user, removing from state", map[string]any{
+			"phone_id": phone_id,
+			"user_id":  user_id,
+		})
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("phone_id", phone_id)
+	d.Set("user_id", user_id)
+
+	return nil
+}
+
+func ResourceUserPhoneAssociationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	parts, err := parseCompositeId(d.Id(), "phone_id", "user_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	phone_id, user_id := parts[0], parts[1]
+
+	if err := duoClient.RemoveUserPhone(ctx, user_id, phone_id); err != nil {
+		return diag.Errorf("Unable to remove phone from user: %s, error: %s", phone_id, err)
+	}
+
+	return nil
+}
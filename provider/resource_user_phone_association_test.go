@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceUserPhoneAssociation(t *testing.T) {
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserPhoneAssociation,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("duo_user_phone_association.test", "phone_id", "duo_phone.test", "id"),
+					resource.TestCheckResourceAttrPair("duo_user_phone_association.test", "user_id", "duo_user.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "duo_user_phone_association.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testAccResourceUserPhoneAssociation = `
+resource "duo_user" "test" {
+	username = "test@test.com"
+}
+
+resource "duo_phone" "test" {
+	number = "+15555550100"
+	type   = "mobile"
+}
+
+resource "duo_user_phone_association" "test" {
+	phone_id = duo_phone.test.id
+	user_id  = duo_user.test.id
+}
+`
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stefangrosaru/terraform-provider-duo/internal/client"
+)
+
+func ResourceUserTokenAssociation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Duo User Hardware Token association resource.",
+
+		CreateContext: ResourceUserTokenAssociationCreate,
+		ReadContext:   ResourceUserTokenAssociationRead,
+		DeleteContext: ResourceUserTokenAssociationDelete,
+		Importer:      importCompositeId("token_id", "user_id"),
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			compositeIdStateUpgraderV0("token_id", "user_id"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"token_id": {
+				Description: "The ID of the hardware token to associate with the user.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"user_id": {
+				Description: "The ID of the user to associate with the hardware token.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func ResourceUserTokenAssociationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	token_id := d.Get("token_id").(string)
+	user_id := d.Get("user_id").(string)
+
+	if err := duoClient.AddUserToken(ctx, user_id, token_id); err != nil {
+		return diag.Errorf("Unable to add token to user: %s, error: %s", user_id, err)
+	}
+
+	d.SetId(buildCompositeId(token_id, user_id))
+	tflog.Trace(ctx, "Successfully added token to user")
+
+	return ResourceUserTokenAssociationRead(ctx, d, meta)
+}
+
+func ResourceUserTokenAssociationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	parts, err := parseCompositeId(d.Id(), "token_id", "user_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	token_id, user_id := parts[0], parts[1]
+
+	tokens, err := duoClient.GetUserTokens(ctx, user_id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Unable to read user tokens: %s, error: %s", user_id, err)
+	}
+
+	found := false
+	for _, token := range tokens {
+		if token.TokenID == token_id {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		tflog.Warn(ctx, "Hardware token is no longer associated with user, removing from state", map[string]any{
+			"token_id": token_id,
+			"user_id":  user_id,
+		})
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("token_id", token_id)
+	d.Set("user_id", user_id)
+
+	return nil
+}
+
+func ResourceUserTokenAssociationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	duoClient := meta.(*client.Client)
+
+	parts, err := parseCompositeId(d.Id(), "token_id", "user_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	token_id, user_id := parts[0], parts[1]
+
+	if err := duoClient.RemoveUserToken(ctx, user_id, token_id); err != nil {
+		return diag.Errorf("Unable to remove token from user: %s, error: %s", token_id, err)
+	}
+
+	return nil
+}
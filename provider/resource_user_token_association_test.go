@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceUserTokenAssociation(t *testing.T) {
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { TestAccPreCheck(t) },
+		ProviderFactories: ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserTokenAssociation,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("duo_user_token_association.test", "token_id", "duo_hardware_token.test", "id"),
+					resource.TestCheckResourceAttrPair("duo_user_token_association.test", "user_id", "duo_user.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccResourceUserTokenAssociation = `
+resource "duo_user" "test" {
+	username = "test@test.com"
+}
+
+resource "duo_hardware_token" "test" {
+	type   = "h6"
+	serial = "123456"
+	secret = "3132333435363738393031323334353637383930"
+}
+
+resource "duo_user_token_association" "test" {
+	token_id = duo_hardware_token.test.id
+	user_id  = duo_user.test.id
+}
+`